@@ -2,15 +2,17 @@ package array
 
 import (
 	"fmt"
+	"iter"
 	"reflect"
-	"sort"
 	"strings"
 
-	"github.com/iVitaliya/logger-go"
+	"github.com/iVitaliya/javascript-go/internal/bounds"
 )
 
-type Array[T comparable] struct {
+type Array[T any] struct {
 	array []T
+	equal Equality[T]
+	trace *float64
 }
 
 // New returns a new empty array
@@ -19,7 +21,7 @@ type Array[T comparable] struct {
 //
 //	arr := array.New[int]()
 //	// arr is now an empty array of type []int
-func New[T comparable]() *Array[T] {
+func New[T any]() *Array[T] {
 	return &Array[T]{
 		array: []T{},
 	}
@@ -35,7 +37,7 @@ func New[T comparable]() *Array[T] {
 //
 //	arr := array.NewWithEntries[int]([]int{1, 2, 3})
 //	// arr is now an array of type []int with elements 1, 2, 3
-func NewWithEntries[T comparable](entries []T) *Array[T] {
+func NewWithEntries[T any](entries []T) *Array[T] {
 	var arr []T
 
 	for _, v := range entries {
@@ -47,23 +49,25 @@ func NewWithEntries[T comparable](entries []T) *Array[T] {
 	}
 }
 
-// From converts a string to an array of its characters.
-// The output is a slice of strings, where each element is a single character.
-// Example: From("hello") returns ["h", "e", "l", "l", "o"].
-func From(str string) []string {
-	var strArr []string
-
-	for i := 0; i < len(str); i++ {
-		strArr = append(strArr, string(str[i]))
-	}
+// NewWithEquality creates a new array holding the given entries that compares its elements
+// with the given equality function instead of SameValueZero.
+// Use it for element types where == is not the comparison you want, such as slices or structs holding them.
+//
+// Example:
+//
+//	arr := array.NewWithEquality(array.DeepEqual[[]byte], []byte("a"), []byte("b"))
+//	arr.Includes([]byte("b")) // true
+func NewWithEquality[T any](equal Equality[T], entries ...T) *Array[T] {
+	arr := NewWithEntries[T](entries)
+	arr.equal = equal
 
-	return strArr
+	return arr
 }
 
 // FromIter applies the given function to each element of the given array,
 // returning the same array. It is similar to the Array.Map function, but
 // does not return a new array.
-func FromIter[T comparable](arr []T, fn func(value T)) []T {
+func FromIter[T any](arr []T, fn func(value T)) []T {
 	for _, v := range arr {
 		fn(v)
 	}
@@ -72,18 +76,27 @@ func FromIter[T comparable](arr []T, fn func(value T)) []T {
 }
 
 // At returns the value at the given index.
+// Negative indices count back from the end of the array, so At(-1) returns the last element.
+// If the index is out of range, it returns a zero value of type T.
 func (array *Array[T]) At(index int) T {
-	for i, v := range array.array {
-		if i == index {
-			return v
-		}
+	if array.tracing() {
+		defer array.traceCall("At", index)()
 	}
 
-	return *new(T)
+	k, ok := bounds.Relative(index, len(array.array))
+	if !ok {
+		return *new(T)
+	}
+
+	return array.array[k]
 }
 
 // Append adds the given values to the end of the array.
 func (array *Array[T]) Append(value ...T) {
+	if array.tracing() {
+		defer array.traceCall("Append", spread(value))()
+	}
+
 	var arr []T = array.array
 
 	for _, v := range value {
@@ -97,6 +110,10 @@ func (array *Array[T]) Append(value ...T) {
 // It does not create a new array, but changes the original array.
 // The return value is the length of the new array.
 func (array *Array[T]) Concat(elements ...[]T) {
+	if array.tracing() {
+		defer array.traceCall("Concat", spread(elements))()
+	}
+
 	var arr []T = array.array
 
 	for _, v := range elements {
@@ -106,69 +123,109 @@ func (array *Array[T]) Concat(elements ...[]T) {
 	array.array = arr
 }
 
-// CopyWithin copies the elements of the given array from the start index up to but not including the end index into a new array.
-// The new array is then returned.
-// The elements are copied in the same order as they appear in the original array.
-// The start and end indices are both inclusive.
-func (array *Array[T]) CopyWithin(start, end int) []T {
-	var arr []T
+// CopyWithin shallow copies the elements from the start index up to but not including the end index
+// to the target index within the same array, and returns the modified array. The length of the array
+// is never changed; elements that would be copied past the end of the array are dropped.
+// The target, start and end indices may be negative, in which case they are treated as offsets from the
+// end of the array. Indices out of range are clamped to the bounds of the array.
+// If no end index is given, elements are copied up to the end of the array.
+func (array *Array[T]) CopyWithin(target, start int, end ...int) []T {
+	if array.tracing() {
+		defer array.traceCall("CopyWithin", target, start, spread(end))()
+	}
 
-	for i := start; i < end; i++ {
-		arr = append(arr, array.array[i-end])
+	if reporting() {
+		report(checkCopyWithin(target, start, end, len(array.array)))
 	}
 
-	return arr
-}
+	var (
+		length = len(array.array)
+		to     = bounds.Clamp(target, length)
+		from   = bounds.Clamp(start, length)
+		final  = bounds.End(end, length)
+		count  = final - from
+	)
+
+	if length-to < count {
+		count = length - to
+	}
+
+	if count > 0 {
+		copy(array.array[to:to+count], array.array[from:from+count])
+	}
 
-// Entries returns the elements of the array as a slice.
-// The returned slice is a view over the same elements as the array.
-// Modifying the returned slice will modify the array.
-func (array *Array[T]) Entries() []T {
 	return array.array
 }
 
+// Entries returns an iterator over the index/value pairs of the array.
+// Like the iterator returned by Array.prototype.entries, it is lazy and live: every step reads the
+// current length and contents of the array, so elements pushed during iteration are visited and
+// iteration ends early if the array shrinks.
+//
+// Example:
+//
+//	for i, v := range arr.Entries() {
+//		fmt.Println(i, v)
+//	}
+func (array *Array[T]) Entries() iter.Seq2[int, T] {
+	if array.tracing() {
+		defer array.traceCall("Entries")()
+	}
+
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(array.array); i++ {
+			if !yield(i, array.array[i]) {
+				return
+			}
+		}
+	}
+}
+
 // Every tests whether all elements in the array pass the test implemented by the provided function.
 // It returns true if all elements pass the test, and false otherwise.
 // It calls the provided function once for each element present in the array until it finds one where falsy is returned.
 // If such an element is found, the Every method immediately returns false.
 // Otherwise, if the callback function returns a truthy value for all elements, Every returns true.
-func (array *Array[T]) Every(fn func(value T) bool) bool {
-	for _, v := range array.array {
-		return fn(v)
+// An empty array passes the test for any function, so Every returns true for it.
+// The callback function takes the element value, its index and the array itself.
+func (array *Array[T]) Every(fn func(value T, index int, array *Array[T]) bool) bool {
+	if array.tracing() {
+		defer array.traceCall("Every", fn)()
 	}
 
-	return false
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		if !fn(array.array[i], i, array) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // Fill fills all the elements of the array from a start index to an end index with a static value.
 // The start index is inclusive, and the end index is exclusive.
 // If the start index is negative, it is treated as an offset from the end of the array.
 // If the end index is negative, it is treated as an offset from the end of the array.
-// If the start index is greater than the end index, the elements are filled in reverse order.
-// If the start index is out of range, the function will return the array unchanged.
-// If the end index is out of range, the function will return the array unchanged.
+// Indices out of range are clamped to the bounds of the array.
+// If no end index is given, the array is filled up to its end.
+// If the start index is not before the end index, the array is returned unchanged.
 func (array *Array[T]) Fill(element T, start int, end ...int) []T {
-	var (
-		endIndex int
-		lastInd  = len(array.array) - 1
-	)
-	if end[0] > lastInd {
-		endIndex = lastInd
-	} else {
-		endIndex = end[0]
+	if array.tracing() {
+		defer array.traceCall("Fill", element, start, spread(end))()
 	}
 
-	if start < 0 || start >= len(array.array) {
-		logger.Error("Start index out of range")
-		return array.array
+	if reporting() {
+		_, _, err := checkRange("Fill", start, end, len(array.array))
+		report(err)
 	}
 
-	if endIndex < 0 || endIndex >= len(array.array) {
-		logger.Error("End index out of range")
-		return array.array
-	}
+	var (
+		length = len(array.array)
+		from   = bounds.Clamp(start, length)
+		final  = bounds.End(end, length)
+	)
 
-	for i := start; i < endIndex; i++ {
+	for i := from; i < final; i++ {
 		array.array[i] = element
 	}
 
@@ -177,28 +234,35 @@ func (array *Array[T]) Fill(element T, start int, end ...int) []T {
 
 // Filter creates a new array with all elements that pass the test implemented by the provided function.
 // It takes a callback function as an argument, which is called once for each element present in the array.
-// The callback function takes one argument, the element value, and returns true if the element passes the test, false otherwise.
-// The returned array is a filtered version of the original array.
+// The callback function takes the element value, its index and the array itself, and returns true if the element passes the test, false otherwise.
+// The returned array is a filtered version of the original array, which remains unchanged.
 // The elements are copied in the same order as they appear in the original array.
-func (array *Array[T]) Filter(fn func(value T) bool) []T {
-	var result []T
-	for _, item := range array.array {
-		if fn(item) {
+func (array *Array[T]) Filter(fn func(value T, index int, array *Array[T]) bool) []T {
+	if array.tracing() {
+		defer array.traceCall("Filter", fn)()
+	}
+
+	result := []T{}
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		if item := array.array[i]; fn(item, i, array) {
 			result = append(result, item)
 		}
 	}
 
-	array.array = result
 	return result
 }
 
 // Find returns the first element in the array that satisfies the provided testing function.
 // The function iterates through the array and returns the element and true if found.
 // If no element is found, it returns a zero value of type T and false.
-// The testing function takes one argument, the element value, and returns true if the element passes the test, false otherwise.
-func (array *Array[T]) Find(fn func(value T) bool) (T, bool) {
-	for _, element := range array.array {
-		if fn(element) {
+// The testing function takes the element value, its index and the array itself, and returns true if the element passes the test, false otherwise.
+func (array *Array[T]) Find(fn func(value T, index int, array *Array[T]) bool) (T, bool) {
+	if array.tracing() {
+		defer array.traceCall("Find", fn)()
+	}
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		if element := array.array[i]; fn(element, i, array) {
 			return element, true
 		}
 	}
@@ -208,11 +272,15 @@ func (array *Array[T]) Find(fn func(value T) bool) (T, bool) {
 
 // FindIndex returns the index of the first element in the array that satisfies the provided testing function.
 // If no element is found, it returns -1.
-// The testing function takes one argument, the element value, and returns true if the element passes the test, false otherwise.
+// The testing function takes the element value, its index and the array itself, and returns true if the element passes the test, false otherwise.
 // The index returned is the index of the element in the original array.
-func (array *Array[T]) FindIndex(fn func(value T) bool) (int, bool) {
-	for i, element := range array.array {
-		if fn(element) {
+func (array *Array[T]) FindIndex(fn func(value T, index int, array *Array[T]) bool) (int, bool) {
+	if array.tracing() {
+		defer array.traceCall("FindIndex", fn)()
+	}
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		if fn(array.array[i], i, array) {
 			return i, true
 		}
 	}
@@ -223,10 +291,14 @@ func (array *Array[T]) FindIndex(fn func(value T) bool) (int, bool) {
 // FindLast returns the last element in the array that satisfies the provided testing function.
 // The function iterates through the array in reverse order, and returns the element and true if found.
 // If no element is found, it returns a zero value of type T and false.
-// The testing function takes one argument, the element value, and returns true if the element passes the test, false otherwise.
-func (array *Array[T]) FindLast(fn func(value T) bool) (T, bool) {
+// The testing function takes the element value, its index and the array itself, and returns true if the element passes the test, false otherwise.
+func (array *Array[T]) FindLast(fn func(value T, index int, array *Array[T]) bool) (T, bool) {
+	if array.tracing() {
+		defer array.traceCall("FindLast", fn)()
+	}
+
 	for i := len(array.array); i > 0; i-- {
-		if fn(array.array[i-1]) {
+		if fn(array.array[i-1], i-1, array) {
 			return array.array[i-1], true
 		}
 	}
@@ -236,11 +308,15 @@ func (array *Array[T]) FindLast(fn func(value T) bool) (T, bool) {
 
 // FindLastIndex returns the index of the last element in the array that satisfies the provided testing function.
 // If no element is found, it returns -1.
-// The testing function takes one argument, the element value, and returns true if the element passes the test, false otherwise.
+// The testing function takes the element value, its index and the array itself, and returns true if the element passes the test, false otherwise.
 // The function iterates through the array in reverse order, and the index returned is the index of the element in the original array.
-func (array *Array[T]) FindLastIndex(fn func(value T) bool) (int, bool) {
+func (array *Array[T]) FindLastIndex(fn func(value T, index int, array *Array[T]) bool) (int, bool) {
+	if array.tracing() {
+		defer array.traceCall("FindLastIndex", fn)()
+	}
+
 	for i := len(array.array); i > 0; i-- {
-		if fn(array.array[i-1]) {
+		if fn(array.array[i-1], i-1, array) {
 			return i - 1, true
 		}
 	}
@@ -248,57 +324,94 @@ func (array *Array[T]) FindLastIndex(fn func(value T) bool) (int, bool) {
 	return -1, false
 }
 
-// Flat returns a new array with the elements of the given array flattened to a single depth.
-// The depth parameter specifies the maximum recursion depth.
-// If the depth is 0, the elements of the array are returned unchanged.
-// If the depth is 1, the elements of the array are flattened one level deep.
-// If the depth is 2 or more, the elements of the array are flattened as many levels deep as the depth.
-// If the depth is negative, the elements of the array are flattened as many levels deep as possible.
-func (array *Array[T]) Flat(depth int) []T {
-	arr := array.array
+// Flat returns a new array with the elements of the array that are lists flattened into it, recursively up
+// to the given depth, like Array.prototype.flat. Lists are Nested lists, slices and Go arrays.
+// A depth of 1 flattens one level, Infinity flattens every level, and a depth below 1 returns a copy of the array.
+// Every flattened element must be of type T, which makes Flat useful for arrays of Nested values and of
+// interface types such as any; if an element is not of type T, for example when flattening an Array[[]int]
+// one level into ints, it returns an error wrapping ErrFlatten. To flatten an Array[Nested[T]] into plain
+// values of type T, use the package-level FlatValues function.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]any{1, []any{2, []any{3}}})
+//	flat, _ := arr.Flat(1)
+//	// flat is now []any{1, 2, []any{3}}
+func (array *Array[T]) Flat(depth int) ([]T, error) {
+	if array.tracing() {
+		defer array.traceCall("Flat", depth)()
+	}
 
-	return flattenArray[T](arr, depth)
+	return flattenInto([]T{}, array.array, depth)
 }
 
 // FlatMap applies the given function to each element of the array,
 // returning a new array with the results of each function call
-// flattened into a single array.
+// flattened exactly one level deep into a single array.
 // It is similar to the Map function, but the callback function can
 // return more than one value, and the returned values are flattened
 // into a single array.
-func (array *Array[T]) FlatMap(fn func(value T) []T) []T {
-	var result []T
+// The callback function takes the element value, its index and the array itself.
+// To map into a different element type, use the package-level FlatMap function.
+func (array *Array[T]) FlatMap(fn func(value T, index int, array *Array[T]) []T) []T {
+	if array.tracing() {
+		defer array.traceCall("FlatMap", fn)()
+	}
+
+	result := []T{}
 
-	for _, item := range array.array {
-		result = append(result, fn(item)...)
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		result = append(result, fn(array.array[i], i, array)...)
 	}
 
 	return result
 }
 
 // ForEach calls the provided function once for each element present in the array in ascending order.
-func (array *Array[T]) ForEach(fn func(value T)) {
-	for _, item := range array.array {
-		fn(item)
+// The callback function takes the element value, its index and the array itself.
+// As in JavaScript, elements appended by the callback are not visited, and iteration stops early if the
+// callback shrinks the array.
+func (array *Array[T]) ForEach(fn func(value T, index int, array *Array[T])) {
+	if array.tracing() {
+		defer array.traceCall("ForEach", fn)()
+	}
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		fn(array.array[i], i, array)
 	}
 }
 
 // Includes determines whether the array includes a certain element, returning true or false as appropriate.
-func (array *Array[T]) Includes(search_term T) bool {
-	for _, v := range array.array {
-		if v == search_term {
-			return true
-		}
+// The optional fromIndex is the position at which to begin searching; if it is negative,
+// it is treated as an offset from the end of the array.
+func (array *Array[T]) Includes(search_term T, fromIndex ...int) bool {
+	if array.tracing() {
+		defer array.traceCall("Includes", search_term, spread(fromIndex))()
 	}
 
-	return false
+	return array.IndexOf(search_term, fromIndex...) != -1
 }
 
 // IndexOf returns the index of the first occurrence of the specified element in the array,
 // or -1 if it is not present.
-func (array *Array[T]) IndexOf(search_term T) int {
-	for i, v := range array.array {
-		if v == search_term {
+// The optional fromIndex is the position at which to begin searching; if it is negative,
+// it is treated as an offset from the end of the array.
+func (array *Array[T]) IndexOf(search_term T, fromIndex ...int) int {
+	if array.tracing() {
+		defer array.traceCall("IndexOf", search_term, spread(fromIndex))()
+	}
+
+	var (
+		from  int
+		equal = array.equality()
+	)
+
+	if len(fromIndex) > 0 {
+		from = bounds.Clamp(fromIndex[0], len(array.array))
+	}
+
+	for i := from; i < len(array.array); i++ {
+		if equal(array.array[i], search_term) {
 			return i
 		}
 	}
@@ -315,6 +428,10 @@ func (array *Array[T]) IndexOf(search_term T) int {
 //
 // Panics if the number of elements is large enough that the length of the resulting string would overflow a int.
 func (array *Array[T]) Join(separator string) string {
+	if array.tracing() {
+		defer array.traceCall("Join", separator)()
+	}
+
 	switch len(array.array) {
 	case 0:
 		return ""
@@ -331,7 +448,7 @@ func (array *Array[T]) Join(separator string) string {
 		n += len(separator) * (len(array.array) - 1)
 	}
 
-	if reflect.TypeOf(array.array[0]).Kind() == reflect.String {
+	if reflect.TypeOf(array.array).Elem().Kind() == reflect.String {
 		for _, item := range array.array {
 			elem := fmt.Sprint(item)
 			if len(elem) > maxInt-n {
@@ -354,27 +471,54 @@ func (array *Array[T]) Join(separator string) string {
 	return b.String()
 }
 
-// Keys returns a slice of the keys of the array.
-// The keys are the indices of the elements in the array.
-// The order of the keys is the same as the order of the elements in the array.
-// The returned slice is a view over the keys of the array,
-// and modifying the returned slice will modify the array.
-func (array *Array[T]) Keys() []int {
-	var result []int
+// Keys returns an iterator over the indices of the array.
+// Like the iterator returned by Array.prototype.keys, it is lazy and live: it keeps yielding indices
+// for as long as they are below the current length of the array.
+func (array *Array[T]) Keys() iter.Seq[int] {
+	if array.tracing() {
+		defer array.traceCall("Keys")()
+	}
 
-	for i, _ := range array.array {
-		result = append(result, i)
+	return func(yield func(int) bool) {
+		for i := 0; i < len(array.array); i++ {
+			if !yield(i) {
+				return
+			}
+		}
 	}
+}
 
-	return result
+// Length returns the number of elements in the array.
+func (array *Array[T]) Length() int {
+	if array.tracing() {
+		defer array.traceCall("Length")()
+	}
+
+	return len(array.array)
 }
 
 // LastIndexOf returns the index of the last occurrence of the specified element in the array,
 // or -1 if it is not present.
 // The elements are searched in reverse order, and the index returned is the index of the element in the original array.
-func (array *Array[T]) LastIndexOf(search_term T) int {
-	for i := len(array.array) - 1; i >= 0; i-- {
-		if array.array[i] == search_term {
+// The optional fromIndex is the position at which to begin searching backwards; if it is negative,
+// it is treated as an offset from the end of the array.
+func (array *Array[T]) LastIndexOf(search_term T, fromIndex ...int) int {
+	if array.tracing() {
+		defer array.traceCall("LastIndexOf", search_term, spread(fromIndex))()
+	}
+
+	from := len(array.array) - 1
+	if len(fromIndex) > 0 {
+		if fromIndex[0] < 0 {
+			from = len(array.array) + fromIndex[0]
+		} else if fromIndex[0] < from {
+			from = fromIndex[0]
+		}
+	}
+
+	equal := array.equality()
+	for i := from; i >= 0; i-- {
+		if equal(array.array[i], search_term) {
 			return i
 		}
 	}
@@ -383,13 +527,18 @@ func (array *Array[T]) LastIndexOf(search_term T) int {
 }
 
 // Map applies the provided function to each element of the array and returns a new array containing the results.
-// The provided function takes an element of the array and returns a slice of elements, which are then flattened into the result array.
-// This function is similar to FlatMap, allowing the callback to return multiple values that are flattened into the final array.
-func (array *Array[T]) Map(fn func(value T) []T) []T {
-	var result []T
+// The provided function takes the element value, its index and the array itself, and returns the new element.
+// The returned array has the same length as the original array, which remains unchanged.
+// To map into a different element type, use the package-level Map function.
+func (array *Array[T]) Map(fn func(value T, index int, array *Array[T]) T) []T {
+	if array.tracing() {
+		defer array.traceCall("Map", fn)()
+	}
 
-	for _, item := range array.array {
-		result = append(result, fn(item)...)
+	result := make([]T, 0, len(array.array))
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		result = append(result, fn(array.array[i], i, array))
 	}
 
 	return result
@@ -398,6 +547,10 @@ func (array *Array[T]) Map(fn func(value T) []T) []T {
 // Pop removes the last element from the array and returns it.
 // If the array is empty, it returns a zero value of type T.
 func (array *Array[T]) Pop() T {
+	if array.tracing() {
+		defer array.traceCall("Pop")()
+	}
+
 	if len(array.array) == 0 {
 		return *new(T)
 	}
@@ -417,12 +570,22 @@ func (array *Array[T]) Pop() T {
 //   - index: The index of the current element being processed in the array.
 //   - array: The array the element belongs to.
 //
-// The initial value is the first element of the array. If the array is empty, it returns a zero value of type T.
-func (array *Array[T]) Reduce(fn func(accumulator T, value T) T) T {
-	var result T
+// The initial value is the first element of the array, and the callback is first called with the second element.
+// If the array is empty, it returns a zero value of type T.
+// To reduce into a different type or start from an explicit initial value, use the package-level Reduce function.
+func (array *Array[T]) Reduce(fn func(accumulator T, value T, index int, array *Array[T]) T) T {
+	if array.tracing() {
+		defer array.traceCall("Reduce", fn)()
+	}
 
-	for _, item := range array.array {
-		result = fn(result, item)
+	if len(array.array) == 0 {
+		return *new(T)
+	}
+
+	result := array.array[0]
+
+	for i, length := 1, len(array.array); i < length && i < len(array.array); i++ {
+		result = fn(result, array.array[i], i, array)
 	}
 
 	return result
@@ -431,16 +594,32 @@ func (array *Array[T]) Reduce(fn func(accumulator T, value T) T) T {
 // ReduceRight applies a function against an accumulator and each element in the array
 // (from right to left) so as to reduce it to a single value.
 //
-// The callback function takes two arguments:
+// The callback function takes four arguments:
 //   - accumulator: The returned value of the previous callback, or the initial value.
 //   - value: The current element being processed in the array.
+//   - index: The index of the current element being processed in the array.
+//   - array: The array the element belongs to.
 //
-// The initial value is the last element of the array. If the array is empty, it returns a zero value of type T.
-func (array *Array[T]) ReduceRight(fn func(accumulator T, value T) T) T {
-	var result T
+// The initial value is the last element of the array, and the callback is first called with the element before it.
+// If the array is empty, it returns a zero value of type T.
+// To reduce into a different type or start from an explicit initial value, use the package-level ReduceRight function.
+func (array *Array[T]) ReduceRight(fn func(accumulator T, value T, index int, array *Array[T]) T) T {
+	if array.tracing() {
+		defer array.traceCall("ReduceRight", fn)()
+	}
 
-	for i := len(array.array) - 1; i >= 0; i-- {
-		result = fn(result, array.array[i])
+	if len(array.array) == 0 {
+		return *new(T)
+	}
+
+	result := array.array[len(array.array)-1]
+
+	for i := len(array.array) - 2; i >= 0; i-- {
+		if i >= len(array.array) {
+			continue
+		}
+
+		result = fn(result, array.array[i], i, array)
 	}
 
 	return result
@@ -450,6 +629,10 @@ func (array *Array[T]) ReduceRight(fn func(accumulator T, value T) T) T {
 // The first element of the array becomes the last, and the last element becomes the first.
 // All other elements are shifted accordingly.
 func (array *Array[T]) Reverse() {
+	if array.tracing() {
+		defer array.traceCall("Reverse")()
+	}
+
 	for i, j := 0, len(array.array)-1; i < j; i, j = i+1, j-1 {
 		array.array[i], array.array[j] = array.array[j], array.array[i]
 	}
@@ -457,28 +640,65 @@ func (array *Array[T]) Reverse() {
 
 // Push adds the given value to the end of the array.
 // The return value is the new length of the array.
-func (array *Array[T]) Push(value T) {
+func (array *Array[T]) Push(value T) int {
+	if array.tracing() {
+		defer array.traceCall("Push", value)()
+	}
+
 	array.array = append(array.array, value)
+
+	return len(array.array)
 }
 
 // Shift removes the first element from the array and returns it.
 // If the array is empty, it returns a zero value of type T.
 // The remaining elements are shifted, and the length of the array is reduced by one.
+// The vacated slot is cleared so the removed element can be garbage collected; for queue-style
+// workloads with many shifts and unshifts, use a Deque instead.
 func (array *Array[T]) Shift() T {
+	if array.tracing() {
+		defer array.traceCall("Shift")()
+	}
+
 	if len(array.array) == 0 {
 		return *new(T)
 	}
 
 	result := array.array[0]
+	array.array[0] = *new(T)
 	array.array = array.array[1:]
 
 	return result
 }
 
 // Slice returns a shallow copy of a portion of the array from the start index to the end index (exclusive).
-// The start index is inclusive, while the end index is exclusive. It panics if the indices are out of range.
-func (array *Array[T]) Slice(start int, end int) []T {
-	return array.array[start:end]
+// The start index is inclusive, while the end index is exclusive.
+// Negative indices are treated as offsets from the end of the array, and indices out of range are
+// clamped to the bounds of the array. If no end index is given, the copy extends to the end of the array.
+func (array *Array[T]) Slice(start int, end ...int) []T {
+	if array.tracing() {
+		defer array.traceCall("Slice", start, spread(end))()
+	}
+
+	if reporting() {
+		_, _, err := checkRange("Slice", start, end, len(array.array))
+		report(err)
+	}
+
+	var (
+		length = len(array.array)
+		from   = bounds.Clamp(start, length)
+		final  = bounds.End(end, length)
+	)
+
+	if final <= from {
+		return []T{}
+	}
+
+	result := make([]T, final-from)
+	copy(result, array.array[from:final])
+
+	return result
 }
 
 // Some tests whether at least one element in the array passes the test implemented by the provided function.
@@ -486,9 +706,14 @@ func (array *Array[T]) Slice(start int, end int) []T {
 // It calls the provided function once for each element present in the array until it finds one where the function returns true.
 // If such an element is found, the Some method immediately returns true.
 // Otherwise, if the callback function returns false for all elements, Some returns false.
-func (array *Array[T]) Some(fn func(value T) bool) bool {
-	for _, item := range array.array {
-		if fn(item) {
+// The callback function takes the element value, its index and the array itself.
+func (array *Array[T]) Some(fn func(value T, index int, array *Array[T]) bool) bool {
+	if array.tracing() {
+		defer array.traceCall("Some", fn)()
+	}
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		if fn(array.array[i], i, array) {
 			return true
 		}
 	}
@@ -496,29 +721,61 @@ func (array *Array[T]) Some(fn func(value T) bool) bool {
 	return false
 }
 
-// Sort sorts the elements of the array in place according to the provided comparison function.
-// The comparison function determines the order of the elements. It takes two arguments, i and j,
-// which are elements of the array, and returns true if the element i should come before the element j.
+// Sort sorts the elements of the array in place and is guaranteed to be stable.
+// The comparison function is optional. Without one, elements are converted to strings the way
+// JavaScript converts values to strings and ordered by their UTF-16 code units, so [10, 9, 1] sorts
+// to [1, 10, 9] exactly as it does in JavaScript.
+// A comparison function takes two elements a and b and returns a negative number if a should come
+// before b, a positive number if a should come after b, and zero if their order does not matter,
+// like the comparison functions of JavaScript and of cmp.Compare.
+// Like undefined in JavaScript, nil interfaces and nil pointers are always sorted to the end of the
+// array without being passed to the comparison function.
 // This method modifies the original array and does not return a new array.
-func (array *Array[T]) Sort(fn func(i, j T) bool) {
-	sort.Slice(array.array, func(i, j int) bool {
-		return fn(array.array[i], array.array[j])
-	})
+func (array *Array[T]) Sort(fn ...func(a, b T) int) {
+	if array.tracing() {
+		defer array.traceCall("Sort", spread(fn))()
+	}
+
+	sortStable(array.array, fn)
 }
 
 // Splice changes the content of the array by removing or replacing existing elements and/or adding new elements in place.
-// The start parameter is the index at which to start changing the array.
-// The end parameter is the index at which to stop changing the array.
-// The value parameter is the element to be added to the array.
-// The return value is the modified array.
-func (array *Array[T]) Splice(start, end int, value T) []T {
-	return append(array.array[:start], append([]T{value}, array.array[end:]...)...)
+// The start parameter is the index at which to start changing the array. If negative, it is treated
+// as an offset from the end of the array. The deleteCount parameter specifies the number of elements to remove
+// from the array starting at the start index, and is clamped to the number of elements available.
+// The items parameter allows for new elements to be inserted into the array at the start index.
+// The return value is a new array containing the removed elements.
+func (array *Array[T]) Splice(start, deleteCount int, items ...T) *Array[T] {
+	if array.tracing() {
+		defer array.traceCall("Splice", start, deleteCount, spread(items))()
+	}
+
+	if reporting() {
+		_, _, err := checkSplice("Splice", start, deleteCount, len(array.array))
+		report(err)
+	}
+
+	start, deleteCount = spliceBounds(start, deleteCount, len(array.array))
+
+	removed := make([]T, deleteCount)
+	copy(removed, array.array[start:start+deleteCount])
+
+	array.array = spliceInto(array.array, start, deleteCount, items)
+
+	return &Array[T]{
+		array: removed,
+		equal: array.equal,
+	}
 }
 
 // ToReverse returns a new array with the elements of the original array in reverse order.
 // The original array remains unchanged, and the returned array contains the same elements
 // but in reversed sequence.
 func (array *Array[T]) ToReverse() []T {
+	if array.tracing() {
+		defer array.traceCall("ToReverse")()
+	}
+
 	var result []T
 
 	for i := len(array.array) - 1; i >= 0; i-- {
@@ -528,18 +785,19 @@ func (array *Array[T]) ToReverse() []T {
 	return result
 }
 
-// ToSorted returns a new array with the elements of the original array sorted according to the provided comparison function.
+// ToSorted returns a new array with the elements of the original array sorted.
 // The original array remains unchanged, and the returned array contains the same elements
 // but in the sorted sequence.
-// The comparison function determines the order of the elements. It takes two arguments, a and b,
-// which are elements of the array, and returns true if the element a should come before the element b.
-func (array *Array[T]) ToSorted(fn func(a, b T) bool) []T {
+// The comparison function is optional and follows the same rules as the comparison function of Sort.
+func (array *Array[T]) ToSorted(fn ...func(a, b T) int) []T {
+	if array.tracing() {
+		defer array.traceCall("ToSorted", spread(fn))()
+	}
+
 	copySlice := make([]T, len(array.array))
 	copy(copySlice, array.array)
 
-	sort.Slice(copySlice, func(i, j int) bool {
-		return fn(copySlice[i], copySlice[j])
-	})
+	sortStable(copySlice, fn)
 
 	return copySlice
 }
@@ -551,23 +809,17 @@ func (array *Array[T]) ToSorted(fn func(a, b T) bool) []T {
 // 'start + deleteCount' exceeds the array bounds, they are clamped appropriately. The 'items' parameter allows
 // for new elements to be added to the array at the 'start' index. The original array remains unchanged.
 func (array *Array[T]) ToSpliced(start, deleteCount int, items ...T) []T {
-	// Ensure start is within bounds.
-	if start < 0 {
-		start = 0
-		start += len(array.array)
-	}
-	if start > len(array.array) {
-		start = len(array.array)
+	if array.tracing() {
+		defer array.traceCall("ToSpliced", start, deleteCount, spread(items))()
 	}
 
-	// Ensure deleteCount is valid.
-	if deleteCount < 0 {
-		deleteCount = 0
-	}
-	if start+deleteCount > len(array.array) {
-		deleteCount = len(array.array) - start
+	if reporting() {
+		_, _, err := checkSplice("ToSpliced", start, deleteCount, len(array.array))
+		report(err)
 	}
 
+	start, deleteCount = spliceBounds(start, deleteCount, len(array.array))
+
 	result := make([]T, 0, len(array.array)-deleteCount+len(items))
 
 	result = append(result, array.array[:start]...)
@@ -580,33 +832,72 @@ func (array *Array[T]) ToSpliced(start, deleteCount int, items ...T) []T {
 // ToString returns a string representation of the array, using the fmt package's Sprint function.
 // It is the same as calling fmt.Sprintf("%v", array.array).
 func (array *Array[T]) ToString() string {
+	if array.tracing() {
+		defer array.traceCall("ToString")()
+	}
+
 	return fmt.Sprintf("%v", array.array)
 }
 
-// Unshift adds one or more elements to the beginning of the array and returns the new array, along with its length.
-// The elements are inserted in the same order as they appear in the parameters.
-// The returned array is a new array with the same elements as the original array, but with the new elements added at the beginning.
-// The original array remains unchanged.
-func (array *Array[T]) Unshift(elements ...T) ([]T, int) {
-	result := append(elements, array.array...)
+// Unshift adds one or more elements to the beginning of the array and returns the new length of the array.
+// The elements are inserted in the same order as they appear in the parameters, so Unshift(1, 2) on [3]
+// results in [1, 2, 3]. The existing elements are moved up, which takes time proportional to the length of
+// the array; for queue-style workloads with many shifts and unshifts, use a Deque instead.
+func (array *Array[T]) Unshift(elements ...T) int {
+	if array.tracing() {
+		defer array.traceCall("Unshift", spread(elements))()
+	}
+
+	array.array = spliceInto(array.array, 0, 0, elements)
 
-	return result, len(result)
+	return len(array.array)
 }
 
-// Values returns the elements of the array as a slice.
-// The returned slice is a view over the same elements as the array.
-// Modifying the returned slice will modify the array.
-func (array *Array[T]) Values() []T {
-	return array.array
+// Equality returns the equality function set with SetEquality, or nil if the array uses the default
+// SameValueZero comparison. It lets code outside this package copy an array along with how it compares.
+func (array *Array[T]) Equality() Equality[T] {
+	return array.equal
+}
+
+// SetEquality changes the equality function used by Includes, IndexOf and LastIndexOf to compare elements.
+// Passing nil restores the default SameValueZero comparison.
+// The return value is the array itself, so the call can be chained.
+func (array *Array[T]) SetEquality(equal Equality[T]) *Array[T] {
+	array.equal = equal
+
+	return array
+}
+
+// Values returns an iterator over the elements of the array.
+// Like the iterator returned by Array.prototype.values, it is lazy and live: every step reads the
+// current contents of the array, so changes made during iteration are observed.
+func (array *Array[T]) Values() iter.Seq[T] {
+	if array.tracing() {
+		defer array.traceCall("Values")()
+	}
+
+	return func(yield func(T) bool) {
+		for i := 0; i < len(array.array); i++ {
+			if !yield(array.array[i]) {
+				return
+			}
+		}
+	}
 }
 
 // With returns a new array with the value at the given index replaced with the given value.
-// If the index is out of range, it returns an error.
+// Negative indices count back from the end of the array, so With(-1, value) replaces the last element.
+// If the index is out of range, it returns a *RangeError wrapping ErrIndexOutOfRange, whatever the Policy.
 // The returned array is a new array with the same elements as the original array, but with the element at the given index replaced.
 // The original array remains unchanged.
 func (array *Array[T]) With(index int, value T) ([]T, error) {
-	if index < 0 || index >= len(array.array) {
-		return nil, fmt.Errorf("index out of range")
+	if array.tracing() {
+		defer array.traceCall("With", index, value)()
+	}
+
+	index, err := checkIndex("With", index, len(array.array))
+	if err != nil {
+		return nil, err
 	}
 
 	newSlice := make([]T, len(array.array))
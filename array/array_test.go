@@ -0,0 +1,311 @@
+package array
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// The expected results of these tables are those of the corresponding Array.prototype methods,
+// following the ToIntegerOrInfinity and relative index steps of the ECMAScript specification.
+
+func TestAt(t *testing.T) {
+	tests := []struct {
+		index int
+		want  int
+	}{
+		{0, 1},
+		{4, 5},
+		{5, 0}, // undefined
+		{-1, 5},
+		{-5, 1},
+		{-6, 0}, // undefined
+		{math.MaxInt, 0},
+		{math.MinInt, 0},
+	}
+
+	for _, tt := range tests {
+		arr := NewWithEntries([]int{1, 2, 3, 4, 5})
+		if got := arr.At(tt.index); got != tt.want {
+			t.Errorf("At(%d) = %d, want %d", tt.index, got, tt.want)
+		}
+	}
+}
+
+func TestWith(t *testing.T) {
+	tests := []struct {
+		index int
+		want  []int
+		err   bool
+	}{
+		{0, []int{9, 2, 3, 4, 5}, false},
+		{4, []int{1, 2, 3, 4, 9}, false},
+		{-1, []int{1, 2, 3, 4, 9}, false},
+		{-5, []int{9, 2, 3, 4, 5}, false},
+		{5, nil, true},
+		{-6, nil, true},
+	}
+
+	for _, tt := range tests {
+		arr := NewWithEntries([]int{1, 2, 3, 4, 5})
+		got, err := arr.With(tt.index, 9)
+
+		if tt.err {
+			if !errors.Is(err, ErrIndexOutOfRange) {
+				t.Errorf("With(%d, 9) error = %v, want ErrIndexOutOfRange", tt.index, err)
+			}
+
+			continue
+		}
+
+		if err != nil || !slices.Equal(got, tt.want) {
+			t.Errorf("With(%d, 9) = %v, %v, want %v", tt.index, got, err, tt.want)
+		}
+
+		if !slices.Equal(arr.array, []int{1, 2, 3, 4, 5}) {
+			t.Errorf("With(%d, 9) changed the array to %v", tt.index, arr.array)
+		}
+	}
+}
+
+func TestSlice(t *testing.T) {
+	tests := []struct {
+		start int
+		end   []int
+		want  []int
+	}{
+		{0, nil, []int{1, 2, 3, 4, 5}},
+		{2, nil, []int{3, 4, 5}},
+		{-2, nil, []int{4, 5}},
+		{-10, nil, []int{1, 2, 3, 4, 5}},
+		{10, nil, []int{}},
+		{1, []int{3}, []int{2, 3}},
+		{1, []int{-1}, []int{2, 3, 4}},
+		{-3, []int{-1}, []int{3, 4}},
+		{3, []int{1}, []int{}},
+		{0, []int{10}, []int{1, 2, 3, 4, 5}},
+		{0, []int{-10}, []int{}},
+	}
+
+	for _, tt := range tests {
+		arr := NewWithEntries([]int{1, 2, 3, 4, 5})
+		if got := arr.Slice(tt.start, tt.end...); !slices.Equal(got, tt.want) {
+			t.Errorf("Slice(%d, %v) = %v, want %v", tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestFill(t *testing.T) {
+	tests := []struct {
+		start int
+		end   []int
+		want  []int
+	}{
+		{0, nil, []int{0, 0, 0, 0, 0}},
+		{1, nil, []int{1, 0, 0, 0, 0}},
+		{1, []int{3}, []int{1, 0, 0, 4, 5}},
+		{-2, nil, []int{1, 2, 3, 0, 0}},
+		{-10, []int{2}, []int{0, 0, 3, 4, 5}},
+		{0, []int{-1}, []int{0, 0, 0, 0, 5}},
+		{10, nil, []int{1, 2, 3, 4, 5}},
+		{3, []int{1}, []int{1, 2, 3, 4, 5}},
+		{0, []int{10}, []int{0, 0, 0, 0, 0}},
+	}
+
+	for _, tt := range tests {
+		arr := NewWithEntries([]int{1, 2, 3, 4, 5})
+		if got := arr.Fill(0, tt.start, tt.end...); !slices.Equal(got, tt.want) {
+			t.Errorf("Fill(0, %d, %v) = %v, want %v", tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestCopyWithin(t *testing.T) {
+	tests := []struct {
+		target, start int
+		end           []int
+		want          []int
+	}{
+		{0, 3, nil, []int{4, 5, 3, 4, 5}},
+		{0, 3, []int{4}, []int{4, 2, 3, 4, 5}},
+		{-2, -3, []int{-1}, []int{1, 2, 3, 3, 4}},
+		{1, 0, nil, []int{1, 1, 2, 3, 4}},
+		{2, 0, []int{2}, []int{1, 2, 1, 2, 5}},
+		{-1, 0, nil, []int{1, 2, 3, 4, 1}},
+		{10, 0, nil, []int{1, 2, 3, 4, 5}},
+		{0, -10, []int{2}, []int{1, 2, 3, 4, 5}},
+		{0, 10, nil, []int{1, 2, 3, 4, 5}},
+		{0, 3, []int{1}, []int{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		arr := NewWithEntries([]int{1, 2, 3, 4, 5})
+		if got := arr.CopyWithin(tt.target, tt.start, tt.end...); !slices.Equal(got, tt.want) {
+			t.Errorf("CopyWithin(%d, %d, %v) = %v, want %v", tt.target, tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestSplice(t *testing.T) {
+	tests := []struct {
+		start, deleteCount int
+		items              []int
+		removed, want      []int
+	}{
+		{1, 2, nil, []int{2, 3}, []int{1, 4, 5}},
+		{-2, 1, nil, []int{4}, []int{1, 2, 3, 5}},
+		{1, 0, []int{9, 8}, []int{}, []int{1, 9, 8, 2, 3, 4, 5}},
+		{10, 1, []int{9}, []int{}, []int{1, 2, 3, 4, 5, 9}},
+		{-10, 1, nil, []int{1}, []int{2, 3, 4, 5}},
+		{2, 10, nil, []int{3, 4, 5}, []int{1, 2}},
+		{2, -1, []int{9}, []int{}, []int{1, 2, 9, 3, 4, 5}},
+		{0, 5, nil, []int{1, 2, 3, 4, 5}, []int{}},
+		{1, 3, []int{9}, []int{2, 3, 4}, []int{1, 9, 5}},
+	}
+
+	for _, tt := range tests {
+		arr := NewWithEntries([]int{1, 2, 3, 4, 5})
+		if got := arr.ToSpliced(tt.start, tt.deleteCount, tt.items...); !slices.Equal(got, tt.want) {
+			t.Errorf("ToSpliced(%d, %d, %v) = %v, want %v", tt.start, tt.deleteCount, tt.items, got, tt.want)
+		}
+
+		removed := arr.Splice(tt.start, tt.deleteCount, tt.items...)
+		if !slices.Equal(removed.array, tt.removed) || !slices.Equal(arr.array, tt.want) {
+			t.Errorf("Splice(%d, %d, %v) = %v leaving %v, want %v leaving %v",
+				tt.start, tt.deleteCount, tt.items, removed.array, arr.array, tt.removed, tt.want)
+		}
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	tests := []struct {
+		search    int
+		fromIndex []int
+		first     int
+		last      int
+		includes  bool
+	}{
+		{2, nil, 1, 3, true},
+		{9, nil, -1, -1, false},
+		{2, []int{2}, 3, 1, true},
+		{2, []int{-2}, 3, 3, true},
+		{2, []int{-3}, 3, 1, true},
+		{2, []int{-10}, 1, -1, true},
+		{2, []int{10}, -1, 3, false},
+		{1, []int{-1}, 4, 4, true},
+		{1, []int{0}, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		arr := NewWithEntries([]int{1, 2, 3, 2, 1})
+
+		if got := arr.IndexOf(tt.search, tt.fromIndex...); got != tt.first {
+			t.Errorf("IndexOf(%d, %v) = %d, want %d", tt.search, tt.fromIndex, got, tt.first)
+		}
+
+		if got := arr.LastIndexOf(tt.search, tt.fromIndex...); got != tt.last {
+			t.Errorf("LastIndexOf(%d, %v) = %d, want %d", tt.search, tt.fromIndex, got, tt.last)
+		}
+
+		if got := arr.Includes(tt.search, tt.fromIndex...); got != tt.includes {
+			t.Errorf("Includes(%d, %v) = %t, want %t", tt.search, tt.fromIndex, got, tt.includes)
+		}
+	}
+}
+
+func TestIncludesSameValueZero(t *testing.T) {
+	arr := NewWithEntries([]float64{0, math.NaN(), 3})
+
+	if !arr.Includes(math.NaN()) {
+		t.Error("Includes(NaN) = false, want true")
+	}
+
+	if !arr.Includes(math.Copysign(0, -1)) {
+		t.Error("Includes(-0) = false, want true")
+	}
+}
+
+func TestLiveIteration(t *testing.T) {
+	// visit ranges over the iterator of the given kind, calling step with the index of every element
+	// before moving on, and returns the index:value pairs it saw. Keys sees the values through At.
+	visit := func(arr *Array[int], kind string, step func(index int)) string {
+		seen := []string{}
+		add := func(i, v int) {
+			seen = append(seen, fmt.Sprint(i, ":", v))
+			step(i)
+		}
+
+		switch kind {
+		case "Entries":
+			for i, v := range arr.Entries() {
+				add(i, v)
+			}
+		case "Keys":
+			for i := range arr.Keys() {
+				add(i, arr.At(i))
+			}
+		case "Values":
+			i := 0
+			for v := range arr.Values() {
+				add(i, v)
+				i++
+			}
+		}
+
+		return strings.Join(seen, " ")
+	}
+
+	tests := []struct {
+		name string
+		step func(arr *Array[int], index int)
+		want string
+	}{
+		{"unchanged", func(arr *Array[int], index int) {}, "0:1 1:2 2:3"},
+		{"pushes are visited", func(arr *Array[int], index int) {
+			if index < 2 {
+				arr.Push(10 + index)
+			}
+		}, "0:1 1:2 2:3 3:10 4:11"},
+		{"pops end early", func(arr *Array[int], index int) {
+			if index == 0 {
+				arr.Pop()
+				arr.Pop()
+			}
+		}, "0:1"},
+		{"splicing shifts later elements", func(arr *Array[int], index int) {
+			if index == 0 {
+				arr.Splice(1, 1)
+			}
+		}, "0:1 1:3"},
+		{"writes ahead are seen", func(arr *Array[int], index int) {
+			if index == 0 {
+				arr.Fill(9, 1)
+			}
+		}, "0:1 1:9 2:9"},
+		{"emptying ends at once", func(arr *Array[int], index int) {
+			arr.Splice(0, arr.Length())
+		}, "0:1"},
+	}
+
+	for _, kind := range []string{"Entries", "Keys", "Values"} {
+		for _, tt := range tests {
+			arr := NewWithEntries([]int{1, 2, 3})
+
+			if got := visit(arr, kind, func(index int) { tt.step(arr, index) }); got != tt.want {
+				t.Errorf("%s %s: visited %s, want %s", kind, tt.name, got, tt.want)
+			}
+		}
+	}
+
+	// The iterators are lazy, so changes made after they are created but before the loop are seen.
+	arr := NewWithEntries([]int{1})
+	values := arr.Values()
+	arr.Push(2)
+
+	if got := slices.Collect(values); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Values() created before a Push yielded %v, want [1 2]", got)
+	}
+}
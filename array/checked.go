@@ -0,0 +1,203 @@
+package array
+
+import "github.com/iVitaliya/javascript-go/internal/bounds"
+
+// CheckedArray is a view of an Array whose fallible methods return an error instead of following the
+// JavaScript rules of clamping indices and returning zero values. Indices may still be negative to count
+// back from the end of the array, but an index or length out of range results in a *RangeError, and
+// taking an element out of an empty array is reported rather than returning a zero value.
+// Changes made through the view are made to the array it was obtained from.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{1, 2, 3})
+//	if _, err := arr.Checked().Slice(1, 5); errors.Is(err, array.ErrIndexOutOfRange) {
+//		// handle the bad index
+//	}
+type CheckedArray[T any] struct {
+	array *Array[T]
+}
+
+// Checked returns a view of the array whose methods return an error for indices and lengths out of range.
+func (array *Array[T]) Checked() *CheckedArray[T] {
+	return &CheckedArray[T]{
+		array: array,
+	}
+}
+
+// Array returns the array the view was obtained from.
+func (checked *CheckedArray[T]) Array() *Array[T] {
+	return checked.array
+}
+
+// At returns the value at the given index. Negative indices count back from the end of the array.
+// If the index is out of range, it returns a *RangeError.
+func (checked *CheckedArray[T]) At(index int) (T, error) {
+	k, err := checkIndex("At", index, len(checked.array.array))
+	if err != nil {
+		return *new(T), err
+	}
+
+	return checked.array.array[k], nil
+}
+
+// CopyWithin copies a part of the array to another location in it, like Array.CopyWithin.
+// If the target, start or end index lies outside the array, it returns a *RangeError and leaves the array unchanged.
+func (checked *CheckedArray[T]) CopyWithin(target, start int, end ...int) ([]T, error) {
+	if err := checkCopyWithin(target, start, end, len(checked.array.array)); err != nil {
+		return nil, err
+	}
+
+	return checked.array.CopyWithin(target, start, end...), nil
+}
+
+// Fill fills the elements of the array from a start index to an end index with a static value, like Array.Fill.
+// If the start or end index lies outside the array, it returns a *RangeError and leaves the array unchanged.
+func (checked *CheckedArray[T]) Fill(element T, start int, end ...int) ([]T, error) {
+	if _, _, err := checkRange("Fill", start, end, len(checked.array.array)); err != nil {
+		return nil, err
+	}
+
+	return checked.array.Fill(element, start, end...), nil
+}
+
+// Pop removes the last element from the array and returns it.
+// If the array is empty, it returns a *RangeError.
+func (checked *CheckedArray[T]) Pop() (T, error) {
+	if _, err := checkIndex("Pop", -1, len(checked.array.array)); err != nil {
+		return *new(T), err
+	}
+
+	return checked.array.Pop(), nil
+}
+
+// Reduce reduces the array to a single value, like Array.Reduce.
+// If the array is empty, there is no initial value to start from and it returns a *RangeError.
+func (checked *CheckedArray[T]) Reduce(fn func(accumulator T, value T, index int, array *Array[T]) T) (T, error) {
+	if _, err := checkIndex("Reduce", 0, len(checked.array.array)); err != nil {
+		return *new(T), err
+	}
+
+	return checked.array.Reduce(fn), nil
+}
+
+// ReduceRight reduces the array to a single value from right to left, like Array.ReduceRight.
+// If the array is empty, there is no initial value to start from and it returns a *RangeError.
+func (checked *CheckedArray[T]) ReduceRight(fn func(accumulator T, value T, index int, array *Array[T]) T) (T, error) {
+	if _, err := checkIndex("ReduceRight", -1, len(checked.array.array)); err != nil {
+		return *new(T), err
+	}
+
+	return checked.array.ReduceRight(fn), nil
+}
+
+// Shift removes the first element from the array and returns it.
+// If the array is empty, it returns a *RangeError.
+func (checked *CheckedArray[T]) Shift() (T, error) {
+	if _, err := checkIndex("Shift", 0, len(checked.array.array)); err != nil {
+		return *new(T), err
+	}
+
+	return checked.array.Shift(), nil
+}
+
+// Slice returns a shallow copy of a portion of the array, like Array.Slice.
+// If the start or end index lies outside the array, it returns a *RangeError.
+func (checked *CheckedArray[T]) Slice(start int, end ...int) ([]T, error) {
+	if _, _, err := checkRange("Slice", start, end, len(checked.array.array)); err != nil {
+		return nil, err
+	}
+
+	return checked.array.Slice(start, end...), nil
+}
+
+// Splice removes, replaces or inserts elements in place and returns the removed elements, like Array.Splice.
+// A delete count reaching past the end of the array removes every element from the start index on.
+// If the start index lies outside the array, or the delete count is negative, it returns a *RangeError and
+// leaves the array unchanged.
+func (checked *CheckedArray[T]) Splice(start, deleteCount int, items ...T) (*Array[T], error) {
+	if _, _, err := checkSplice("Splice", start, deleteCount, len(checked.array.array)); err != nil {
+		return nil, err
+	}
+
+	return checked.array.Splice(start, deleteCount, items...), nil
+}
+
+// ToSpliced returns a new array with elements removed, replaced or added, like Array.ToSpliced.
+// It returns a *RangeError under the same conditions as Splice.
+func (checked *CheckedArray[T]) ToSpliced(start, deleteCount int, items ...T) ([]T, error) {
+	if _, _, err := checkSplice("ToSpliced", start, deleteCount, len(checked.array.array)); err != nil {
+		return nil, err
+	}
+
+	return checked.array.ToSpliced(start, deleteCount, items...), nil
+}
+
+// With returns a new array with the value at the given index replaced. See Array.With.
+func (checked *CheckedArray[T]) With(index int, value T) ([]T, error) {
+	return checked.array.With(index, value)
+}
+
+// checkIndex resolves the relative index of an element, which must lie in [-length, length).
+func checkIndex(op string, index, length int) (int, error) {
+	k, ok := bounds.Relative(index, length)
+	if !ok {
+		return 0, indexError(op, index, length)
+	}
+
+	return k, nil
+}
+
+// checkBound resolves a relative bound of a range of elements, which must lie in [-length, length].
+func checkBound(op string, index, length int) (int, error) {
+	if index < -length || index > length {
+		return 0, indexError(op, index, length)
+	}
+
+	return bounds.Clamp(index, length), nil
+}
+
+// checkRange resolves the start and optional end of a range of elements, as taken by Fill and Slice.
+func checkRange(op string, start int, end []int, length int) (int, int, error) {
+	from, err := checkBound(op, start, length)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(end) == 0 {
+		return from, length, nil
+	}
+
+	final, err := checkBound(op, end[0], length)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return from, final, nil
+}
+
+// checkCopyWithin checks the target, start and optional end of CopyWithin.
+func checkCopyWithin(target, start int, end []int, length int) error {
+	if _, err := checkBound("CopyWithin", target, length); err != nil {
+		return err
+	}
+
+	_, _, err := checkRange("CopyWithin", start, end, length)
+	return err
+}
+
+// checkSplice resolves the start and delete count of Splice and ToSpliced.
+func checkSplice(op string, start, deleteCount, length int) (int, int, error) {
+	from, err := checkBound(op, start, length)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// A delete count reaching past the end of the array removes the rest of it, as it does in JavaScript,
+	// so that Splice(i, arr.Length()) stays valid. Only a negative count is an error.
+	if deleteCount < 0 {
+		return 0, 0, lengthError(op, deleteCount, length)
+	}
+
+	return from, min(deleteCount, length-from), nil
+}
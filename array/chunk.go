@@ -0,0 +1,128 @@
+package array
+
+import "math"
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Chunk splits the elements of the given array into arrays of the given size, like lodash's chunk.
+// The last chunk holds the remaining elements and may be shorter. If the size is less than 1, it applies
+// the Policy and returns an empty array. Each chunk is a new array using the equality function of the given array.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{1, 2, 3, 4, 5})
+//	array.Chunk(arr, 2) // [[1 2] [3 4] [5]]
+func Chunk[T any](array *Array[T], size int) *Array[*Array[T]] {
+	result := []*Array[T]{}
+
+	if size < 1 {
+		report(lengthError("Chunk", size, len(array.array)))
+		return &Array[*Array[T]]{array: result}
+	}
+
+	for start := 0; start < len(array.array); start += size {
+		end := min(start+size, len(array.array))
+		result = append(result, array.derive(cloneSlice(array.array[start:end])))
+	}
+
+	return &Array[*Array[T]]{
+		array: result,
+	}
+}
+
+// Windows returns the sliding windows of the given size over the elements of the given array, starting a new
+// window every step elements. Only complete windows are returned, so an array shorter than the size has no
+// windows. If the size or the step is less than 1, it applies the Policy and returns an empty array.
+// Each window is a new array using the equality function of the given array.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{1, 2, 3, 4, 5})
+//	array.Windows(arr, 3, 1) // [[1 2 3] [2 3 4] [3 4 5]]
+//	array.Windows(arr, 2, 2) // [[1 2] [3 4]]
+func Windows[T any](array *Array[T], size, step int) *Array[*Array[T]] {
+	result := []*Array[T]{}
+
+	if size < 1 || step < 1 {
+		report(lengthError("Windows", min(size, step), len(array.array)))
+		return &Array[*Array[T]]{array: result}
+	}
+
+	for start := 0; start+size <= len(array.array); start += step {
+		result = append(result, array.derive(cloneSlice(array.array[start:start+size])))
+	}
+
+	return &Array[*Array[T]]{
+		array: result,
+	}
+}
+
+// Interleave returns a new array taking one element from each of the given arrays in turn.
+// When an array runs out of elements, it is skipped and the others carry on.
+//
+// Example:
+//
+//	a := array.NewWithEntries([]int{1, 2, 3})
+//	b := array.NewWithEntries([]int{10, 20})
+//	array.Interleave(a, b) // [1 10 2 20 3]
+func Interleave[T any](arrays ...*Array[T]) *Array[T] {
+	var (
+		total   = 0
+		longest = 0
+	)
+
+	for _, arr := range arrays {
+		total += len(arr.array)
+		longest = max(longest, len(arr.array))
+	}
+
+	result := make([]T, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, arr := range arrays {
+			if i < len(arr.array) {
+				result = append(result, arr.array[i])
+			}
+		}
+	}
+
+	return &Array[T]{
+		array: result,
+	}
+}
+
+// Range returns a new array of numbers progressing from start up to, but not including, end by the given
+// step, like lodash's range. A negative step counts down. If the step is zero or points away from end,
+// it returns an empty array. Floating-point elements are computed as start + index*step, so rounding
+// errors do not accumulate.
+//
+// Example:
+//
+//	array.Range(0, 5, 1)      // [0 1 2 3 4]
+//	array.Range(10, 0, -3)    // [10 7 4 1]
+//	array.Range(0.0, 1, 0.25) // [0 0.25 0.5 0.75]
+func Range[T Number](start, end, step T) *Array[T] {
+	result := []T{}
+
+	if step == 0 {
+		return &Array[T]{array: result}
+	}
+
+	// A NaN or infinite count, from NaN or infinite bounds, also results in an empty array.
+	count := math.Ceil((float64(end) - float64(start)) / float64(step))
+	if !(count > 0) || math.IsInf(count, 1) {
+		return &Array[T]{array: result}
+	}
+
+	for i := 0; i < int(count); i++ {
+		result = append(result, start+T(i)*step)
+	}
+
+	return &Array[T]{
+		array: result,
+	}
+}
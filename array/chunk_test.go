@@ -0,0 +1,159 @@
+package array
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// groups shows an array of arrays as their elements, such as "[1 2] [3]".
+func groups[T any](arr *Array[*Array[T]]) string {
+	parts := make([]string, len(arr.array))
+	for i, group := range arr.array {
+		parts[i] = fmt.Sprint(group.array)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		values []int
+		size   int
+		want   string
+	}{
+		{[]int{1, 2, 3, 4, 5}, 2, "[1 2] [3 4] [5]"},
+		{[]int{1, 2, 3, 4}, 2, "[1 2] [3 4]"},
+		{[]int{1, 2, 3}, 1, "[1] [2] [3]"},
+		{[]int{1, 2, 3}, 3, "[1 2 3]"},
+		{[]int{1, 2, 3}, 10, "[1 2 3]"},
+		{nil, 2, ""},
+		{[]int{1, 2}, 0, ""},
+		{[]int{1, 2}, -1, ""},
+	}
+
+	for _, tt := range tests {
+		if got := groups(Chunk(NewWithEntries(tt.values), tt.size)); got != tt.want {
+			t.Errorf("Chunk(%v, %d) = %s, want %s", tt.values, tt.size, got, tt.want)
+		}
+	}
+
+	arr := NewWithEntries([]string{"a", "B"}).SetEquality(strings.EqualFold)
+	chunks := Chunk(arr, 1)
+	chunks.array[0].array[0] = "z"
+
+	if arr.array[0] != "a" || !chunks.array[1].Includes("b") {
+		t.Error("the chunks share elements with the array or lost its equality function")
+	}
+}
+
+func TestWindows(t *testing.T) {
+	tests := []struct {
+		values     []int
+		size, step int
+		want       string
+	}{
+		{[]int{1, 2, 3, 4, 5}, 3, 1, "[1 2 3] [2 3 4] [3 4 5]"},
+		{[]int{1, 2, 3, 4, 5}, 2, 2, "[1 2] [3 4]"},
+		{[]int{1, 2, 3, 4, 5}, 2, 3, "[1 2] [4 5]"},
+		{[]int{1, 2, 3, 4, 5}, 1, 5, "[1]"},
+		{[]int{1, 2, 3}, 3, 1, "[1 2 3]"},
+		{[]int{1, 2, 3}, 4, 1, ""},
+		{[]int{1, 2, 3}, 1, 10, "[1]"},
+		{nil, 1, 1, ""},
+		{[]int{1, 2}, 0, 1, ""},
+		{[]int{1, 2}, 1, 0, ""},
+	}
+
+	for _, tt := range tests {
+		if got := groups(Windows(NewWithEntries(tt.values), tt.size, tt.step)); got != tt.want {
+			t.Errorf("Windows(%v, %d, %d) = %s, want %s", tt.values, tt.size, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestChunkPolicy(t *testing.T) {
+	withPolicy(t, PolicyPanic, func() {
+		tests := []struct {
+			name string
+			run  func()
+		}{
+			{"Chunk(0)", func() { Chunk(NewWithEntries([]int{1}), 0) }},
+			{"Windows(0, 1)", func() { Windows(NewWithEntries([]int{1}), 0, 1) }},
+			{"Windows(1, -1)", func() { Windows(NewWithEntries([]int{1}), 1, -1) }},
+		}
+
+		for _, tt := range tests {
+			func() {
+				defer func() {
+					err, _ := recover().(error)
+					if !errors.Is(err, ErrInvalidLength) {
+						t.Errorf("%s under PolicyPanic panicked with %v, want ErrInvalidLength", tt.name, err)
+					}
+				}()
+
+				tt.run()
+			}()
+		}
+	})
+}
+
+func TestInterleave(t *testing.T) {
+	tests := []struct {
+		arrays [][]int
+		want   string
+	}{
+		{[][]int{{1, 2, 3}, {10, 20}}, "[1 10 2 20 3]"},
+		{[][]int{{1}, {10, 20, 30}, {100, 200}}, "[1 10 100 20 200 30]"},
+		{[][]int{{}, {1, 2}}, "[1 2]"},
+		{[][]int{{1, 2}}, "[1 2]"},
+		{nil, "[]"},
+	}
+
+	for _, tt := range tests {
+		arrays := make([]*Array[int], len(tt.arrays))
+		for i, values := range tt.arrays {
+			arrays[i] = NewWithEntries(values)
+		}
+
+		if got := fmt.Sprint(Interleave(arrays...).array); got != tt.want {
+			t.Errorf("Interleave(%v) = %s, want %s", tt.arrays, got, tt.want)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want string
+	}{
+		{"up", Range(0, 5, 1).array, "[0 1 2 3 4]"},
+		{"up by 2", Range(1, 6, 2).array, "[1 3 5]"},
+		{"down", Range(10, 0, -3).array, "[10 7 4 1]"},
+		{"down to a negative end", Range(2, -2, -1).array, "[2 1 0 -1]"},
+		{"zero step", Range(0, 5, 0).array, "[]"},
+		{"negative step away from end", Range(0, 5, -1).array, "[]"},
+		{"positive step away from end", Range(5, 0, 1).array, "[]"},
+		{"empty range", Range(3, 3, 1).array, "[]"},
+		{"floats", Range(0.0, 1, 0.25).array, "[0 0.25 0.5 0.75]"},
+		{"floats without drift", len(Range(0.0, 1, 0.1).array), "10"},
+		{"floats down", Range(1.0, 0, -0.5).array, "[1 0.5]"},
+		{"NaN bound", Range(0, math.NaN(), 1).array, "[]"},
+		{"infinite bound", Range(0, math.Inf(1), 1).array, "[]"},
+		{"unsigned", Range[uint8](250, 255, 2).array, "[250 252 254]"},
+		{"unsigned backwards", Range[uint](5, 0, 1).array, "[]"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprint(tt.got); got != tt.want {
+			t.Errorf("Range %s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if got := Range(0.0, 1, 0.1).array[7]; got != 0.7000000000000001 && got != 0.7 {
+		t.Errorf("Range(0, 1, 0.1)[7] = %v, want start + 7*step", got)
+	}
+}
@@ -0,0 +1,369 @@
+package array
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/iVitaliya/javascript-go/internal/bounds"
+)
+
+// minDequeCapacity is the smallest capacity a non-empty deque allocates. It must be a power of two.
+const minDequeCapacity = 16
+
+// Deque is a double-ended queue with the same API as Array for the operations it supports.
+// It is backed by a growable ring buffer, so Push, Pop, Shift and Unshift all run in amortised
+// constant time, where Shift and Unshift on an Array have to move the remaining elements.
+// Splice moves whichever side of the spliced range is shorter.
+// Use it for queue-style workloads that add and remove elements at both ends.
+//
+// Deque supports the element access, search, queue and splicing methods of Array: Append, At, Entries,
+// Fill, ForEach, Includes, IndexOf, Join, Keys, Length, Pop, Push, Shift, Slice, Splice, ToString, Unshift
+// and Values. Fill returns the deque rather than a slice, since its elements are not kept in order in a
+// single slice. The callback, sorting and reordering methods of Array, such as Map, Filter, Reduce, Sort
+// and Reverse, are not provided; use ToArray for those.
+//
+// Example:
+//
+//	queue := array.NewDeque[string]()
+//	queue.Append("a", "b")
+//	queue.Unshift("z")
+//	queue.Shift() // "z"
+type Deque[T any] struct {
+	buf    []T
+	head   int
+	length int
+	equal  Equality[T]
+}
+
+// NewDeque returns a new empty deque.
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// NewDequeWithEntries creates a new deque holding a copy of the given entries, in order.
+func NewDequeWithEntries[T any](entries []T) *Deque[T] {
+	deque := NewDeque[T]()
+	deque.Append(entries...)
+
+	return deque
+}
+
+// Append adds the given values to the end of the deque.
+func (deque *Deque[T]) Append(values ...T) {
+	deque.grow(len(values))
+
+	for _, v := range values {
+		deque.buf[deque.slot(deque.length)] = v
+		deque.length++
+	}
+}
+
+// At returns the value at the given index.
+// Negative indices count back from the end of the deque, so At(-1) returns the last element.
+// If the index is out of range, it returns a zero value of type T.
+func (deque *Deque[T]) At(index int) T {
+	k, ok := bounds.Relative(index, deque.length)
+	if !ok {
+		return *new(T)
+	}
+
+	return deque.buf[deque.slot(k)]
+}
+
+// Entries returns an iterator over the index/value pairs of the deque.
+// Like Array.Entries it is live, reading the current length and contents of the deque on every step.
+func (deque *Deque[T]) Entries() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < deque.length; i++ {
+			if !yield(i, deque.buf[deque.slot(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Fill fills the elements of the deque from a start index to an end index with a static value, like Array.Fill.
+// Negative indices count back from the end of the deque, and indices out of range are clamped to its bounds.
+// If no end index is given, the deque is filled up to its end. It returns the deque to allow chaining.
+func (deque *Deque[T]) Fill(element T, start int, end ...int) *Deque[T] {
+	for i := bounds.Clamp(start, deque.length); i < bounds.End(end, deque.length); i++ {
+		deque.buf[deque.slot(i)] = element
+	}
+
+	return deque
+}
+
+// ForEach calls the provided function once for each element present in the deque, from front to back.
+// The callback function takes the element value, its index and the deque itself.
+func (deque *Deque[T]) ForEach(fn func(value T, index int, deque *Deque[T])) {
+	for i, length := 0, deque.length; i < length && i < deque.length; i++ {
+		fn(deque.buf[deque.slot(i)], i, deque)
+	}
+}
+
+// Includes determines whether the deque includes a certain element, returning true or false as appropriate.
+func (deque *Deque[T]) Includes(search_term T) bool {
+	return deque.IndexOf(search_term) != -1
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in the deque, or -1 if it is not present.
+// Elements are compared with the equality function of the deque, which defaults to SameValueZero.
+func (deque *Deque[T]) IndexOf(search_term T) int {
+	equal := deque.equality()
+	for i := 0; i < deque.length; i++ {
+		if equal(deque.buf[deque.slot(i)], search_term) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Join joins all elements of the deque into a string, separated by the given separator.
+func (deque *Deque[T]) Join(separator string) string {
+	var b strings.Builder
+
+	for i := 0; i < deque.length; i++ {
+		if i > 0 {
+			b.WriteString(separator)
+		}
+
+		b.WriteString(fmt.Sprint(deque.buf[deque.slot(i)]))
+	}
+
+	return b.String()
+}
+
+// Keys returns an iterator over the indices of the deque.
+func (deque *Deque[T]) Keys() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < deque.length; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Length returns the number of elements in the deque.
+func (deque *Deque[T]) Length() int {
+	return deque.length
+}
+
+// Pop removes the last element from the deque and returns it.
+// If the deque is empty, it returns a zero value of type T.
+func (deque *Deque[T]) Pop() T {
+	if deque.length == 0 {
+		return *new(T)
+	}
+
+	k := deque.slot(deque.length - 1)
+	result := deque.buf[k]
+	deque.buf[k] = *new(T)
+	deque.length--
+
+	deque.shrink()
+	return result
+}
+
+// Push adds the given value to the end of the deque and returns its new length. To add several values
+// at once, use Append.
+func (deque *Deque[T]) Push(value T) int {
+	deque.grow(1)
+
+	deque.buf[deque.slot(deque.length)] = value
+	deque.length++
+
+	return deque.length
+}
+
+// SetEquality changes the equality function used by Includes and IndexOf to compare elements.
+// Passing nil restores the default SameValueZero comparison.
+func (deque *Deque[T]) SetEquality(equal Equality[T]) *Deque[T] {
+	deque.equal = equal
+
+	return deque
+}
+
+// Shift removes the first element from the deque and returns it.
+// If the deque is empty, it returns a zero value of type T.
+func (deque *Deque[T]) Shift() T {
+	if deque.length == 0 {
+		return *new(T)
+	}
+
+	result := deque.buf[deque.head]
+	deque.buf[deque.head] = *new(T)
+	deque.head = (deque.head + 1) & (len(deque.buf) - 1)
+	deque.length--
+
+	deque.shrink()
+	return result
+}
+
+// Slice returns a copy of the elements of the deque from the start index to the end index (exclusive),
+// like Array.Slice. Negative indices count back from the end of the deque, and indices out of range are
+// clamped to its bounds. If no end index is given, the copy runs to the end of the deque.
+func (deque *Deque[T]) Slice(start int, end ...int) []T {
+	var (
+		from  = bounds.Clamp(start, deque.length)
+		final = bounds.End(end, deque.length)
+	)
+
+	if final <= from {
+		return []T{}
+	}
+
+	result := make([]T, final-from)
+	for i := range result {
+		result[i] = deque.buf[deque.slot(from+i)]
+	}
+
+	return result
+}
+
+// Splice removes, replaces or inserts elements in place and returns the removed elements in a new Array,
+// like Array.Splice and following the same rules for the start index and delete count. Only the elements
+// on the shorter side of the spliced range are moved, so splicing near either end of the deque is cheap.
+//
+// Example:
+//
+//	deque := array.NewDequeWithEntries([]int{1, 2, 3, 4})
+//	deque.Splice(1, 2, 9) // [2, 3], leaving [1, 9, 4]
+func (deque *Deque[T]) Splice(start, deleteCount int, items ...T) *Array[T] {
+	from, count := spliceBounds(start, deleteCount, deque.length)
+
+	removed := &Array[T]{
+		array: deque.Slice(from, from+count),
+		equal: deque.equal,
+	}
+
+	if from < deque.length-from-count {
+		front := deque.Slice(0, from)
+		deque.drop(0, from+count)
+		deque.Unshift(items...)
+		deque.Unshift(front...)
+	} else {
+		back := deque.Slice(from + count)
+		deque.drop(from, deque.length-from)
+		deque.Append(items...)
+		deque.Append(back...)
+	}
+
+	deque.shrink()
+	return removed
+}
+
+// ToArray returns a new Array holding the elements of the deque, from front to back.
+func (deque *Deque[T]) ToArray() *Array[T] {
+	result := make([]T, deque.length)
+	for i := range result {
+		result[i] = deque.buf[deque.slot(i)]
+	}
+
+	return &Array[T]{
+		array: result,
+		equal: deque.equal,
+	}
+}
+
+// ToString returns a string representation of the deque, formatted like Array.ToString.
+func (deque *Deque[T]) ToString() string {
+	return deque.ToArray().ToString()
+}
+
+// Unshift adds the given values to the beginning of the deque and returns its new length.
+// The values are inserted in the same order as they appear in the parameters, so Unshift(1, 2) on [3]
+// results in [1, 2, 3].
+func (deque *Deque[T]) Unshift(values ...T) int {
+	deque.grow(len(values))
+
+	for i := len(values) - 1; i >= 0; i-- {
+		deque.head = (deque.head - 1) & (len(deque.buf) - 1)
+		deque.buf[deque.head] = values[i]
+		deque.length++
+	}
+
+	return deque.length
+}
+
+// Values returns an iterator over the elements of the deque, from front to back.
+// Like Array.Values it is live, reading the current length and contents of the deque on every step.
+func (deque *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < deque.length; i++ {
+			if !yield(deque.buf[deque.slot(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// slot returns the position in the ring buffer of the element at the given index.
+func (deque *Deque[T]) slot(index int) int {
+	return (deque.head + index) & (len(deque.buf) - 1)
+}
+
+// equality returns the equality function of the deque, or SameValueZero resolved for its element type.
+func (deque *Deque[T]) equality() Equality[T] {
+	if deque.equal == nil {
+		return sameValueZero[T]()
+	}
+
+	return deque.equal
+}
+
+// drop removes n elements from the front of the deque when start is 0, or from its back otherwise,
+// clearing their slots so the removed elements can be garbage collected.
+func (deque *Deque[T]) drop(start, n int) {
+	for i := start; i < start+n; i++ {
+		deque.buf[deque.slot(i)] = *new(T)
+	}
+
+	if start == 0 {
+		deque.head = deque.slot(n)
+	}
+
+	deque.length -= n
+}
+
+// grow makes room for n more elements, doubling the capacity of the ring buffer as often as needed.
+func (deque *Deque[T]) grow(n int) {
+	if deque.length+n <= len(deque.buf) {
+		return
+	}
+
+	capacity := max(len(deque.buf), minDequeCapacity)
+	for capacity < deque.length+n {
+		capacity *= 2
+	}
+
+	deque.resize(capacity)
+}
+
+// shrink halves the capacity of the ring buffer once it is at most a quarter full,
+// so a deque that has been drained does not hold on to its peak memory.
+func (deque *Deque[T]) shrink() {
+	if len(deque.buf) > minDequeCapacity && deque.length <= len(deque.buf)/4 {
+		deque.resize(len(deque.buf) / 2)
+	}
+}
+
+// resize moves the elements into a new ring buffer of the given capacity, which must be a power of two,
+// starting at its first slot.
+func (deque *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+
+	if deque.length > 0 {
+		if end := deque.head + deque.length; end <= len(deque.buf) {
+			copy(buf, deque.buf[deque.head:end])
+		} else {
+			n := copy(buf, deque.buf[deque.head:])
+			copy(buf[n:], deque.buf[:deque.length-n])
+		}
+	}
+
+	deque.buf = buf
+	deque.head = 0
+}
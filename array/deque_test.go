@@ -0,0 +1,150 @@
+package array
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// wrapped returns a deque holding the given entries whose ring buffer wraps around its end,
+// so that the tests cover elements split between the two halves of the buffer.
+func wrapped(entries []int) *Deque[int] {
+	deque := NewDeque[int]()
+	for i := 0; i < minDequeCapacity-2; i++ {
+		deque.Push(0)
+		deque.Shift()
+	}
+
+	deque.Append(entries...)
+	return deque
+}
+
+func TestDequeSplice(t *testing.T) {
+	tests := []struct {
+		start, deleteCount int
+		items              []int
+	}{
+		{1, 2, nil},
+		{-2, 1, nil},
+		{1, 0, []int{9, 8}},
+		{10, 1, []int{9}},
+		{-10, 1, nil},
+		{2, 10, nil},
+		{2, -1, []int{9}},
+		{0, 5, nil},
+		{4, 1, []int{9, 8, 7}},
+		{1, 3, []int{9}},
+	}
+
+	for _, tt := range tests {
+		entries := []int{1, 2, 3, 4, 5}
+		want := NewWithEntries(entries).ToSpliced(tt.start, tt.deleteCount, tt.items...)
+		removed := NewWithEntries(entries).Splice(tt.start, tt.deleteCount, tt.items...).array
+
+		for _, deque := range []*Deque[int]{NewDequeWithEntries(entries), wrapped(entries)} {
+			got := deque.Splice(tt.start, tt.deleteCount, tt.items...)
+
+			if !slices.Equal(got.array, removed) || !slices.Equal(deque.Slice(0), want) {
+				t.Errorf("Splice(%d, %d, %v) = %v leaving %v, want %v leaving %v",
+					tt.start, tt.deleteCount, tt.items, got.array, deque.Slice(0), removed, want)
+			}
+		}
+	}
+
+	deque := NewDequeWithEntries([]string{"a", "B"}).SetEquality(strings.EqualFold)
+	if removed := deque.Splice(0, 2); !removed.Includes("b") {
+		t.Error("the array returned by Splice lost the equality function of the deque")
+	}
+}
+
+func TestDequeSliceAndFill(t *testing.T) {
+	entries := []int{1, 2, 3, 4, 5}
+
+	for _, deque := range []*Deque[int]{NewDequeWithEntries(entries), wrapped(entries)} {
+		if got, want := deque.Slice(-3, -1), []int{3, 4}; !slices.Equal(got, want) {
+			t.Errorf("Slice(-3, -1) = %v, want %v", got, want)
+		}
+
+		if got, want := deque.Fill(0, 1, 3).Slice(0), []int{1, 0, 0, 4, 5}; !slices.Equal(got, want) {
+			t.Errorf("Fill(0, 1, 3) = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestDequeQueue(t *testing.T) {
+	deque := NewDeque[int]()
+
+	for i := 0; i < 100; i++ {
+		if n := deque.Push(i); n != 2*i+1 {
+			t.Fatalf("Push(%d) = %d, want the new length %d", i, n, 2*i+1)
+		}
+
+		if n := deque.Unshift(-i); n != 2*i+2 {
+			t.Fatalf("Unshift(%d) = %d, want the new length %d", -i, n, 2*i+2)
+		}
+	}
+
+	for i := 99; i >= 0; i-- {
+		if got := deque.Shift(); got != -i {
+			t.Fatalf("Shift() = %d, want %d", got, -i)
+		}
+
+		if got := deque.Pop(); got != i {
+			t.Fatalf("Pop() = %d, want %d", got, i)
+		}
+	}
+
+	if deque.Length() != 0 || len(deque.buf) != minDequeCapacity {
+		t.Errorf("drained deque has length %d and capacity %d", deque.Length(), len(deque.buf))
+	}
+}
+
+const benchmarkQueueLength = 10000
+
+func BenchmarkDequeShift(b *testing.B) {
+	deque := NewDequeWithEntries(make([]int, benchmarkQueueLength))
+
+	for i := 0; i < b.N; i++ {
+		deque.Push(deque.Shift())
+	}
+}
+
+func BenchmarkArrayShift(b *testing.B) {
+	arr := NewWithEntries(make([]int, benchmarkQueueLength))
+
+	for i := 0; i < b.N; i++ {
+		arr.Push(arr.Shift())
+	}
+}
+
+func BenchmarkDequeUnshift(b *testing.B) {
+	deque := NewDequeWithEntries(make([]int, benchmarkQueueLength))
+
+	for i := 0; i < b.N; i++ {
+		deque.Unshift(deque.Pop())
+	}
+}
+
+func BenchmarkArrayUnshift(b *testing.B) {
+	arr := NewWithEntries(make([]int, benchmarkQueueLength))
+
+	for i := 0; i < b.N; i++ {
+		arr.Unshift(arr.Pop())
+	}
+}
+
+func BenchmarkDequeSplice(b *testing.B) {
+	deque := NewDequeWithEntries(make([]int, benchmarkQueueLength))
+
+	for i := 0; i < b.N; i++ {
+		deque.Splice(10, 1, i)
+	}
+}
+
+func BenchmarkArraySplice(b *testing.B) {
+	arr := NewWithEntries(make([]int, benchmarkQueueLength))
+
+	for i := 0; i < b.N; i++ {
+		arr.Splice(10, 1, i)
+	}
+}
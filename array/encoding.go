@@ -0,0 +1,115 @@
+package array
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the array as a JSON array of its elements.
+// An empty array is encoded as [] rather than null. The method has a pointer receiver, like the methods of
+// Array, so an Array held by value in a struct field must be addressable to be encoded this way; prefer
+// *Array fields.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{1, 2, 3})
+//	data, _ := json.Marshal(arr)
+//	// data is now []byte("[1,2,3]")
+func (array *Array[T]) MarshalJSON() ([]byte, error) {
+	if array == nil {
+		return []byte("null"), nil
+	}
+
+	if array.array == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(array.array)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the elements of the array with those of a JSON array.
+// A JSON null results in an empty array. The equality function of the array is kept.
+func (array *Array[T]) UnmarshalJSON(data []byte) error {
+	var entries []T
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+
+	if entries == nil {
+		entries = []T{}
+	}
+
+	array.array = entries
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the JSON encoding of the array,
+// so that an array can be used wherever a text value is expected, such as in flags or YAML.
+func (array *Array[T]) MarshalText() ([]byte, error) {
+	return array.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding the JSON encoding produced by MarshalText.
+func (array *Array[T]) UnmarshalText(text []byte) error {
+	return array.UnmarshalJSON(text)
+}
+
+// GobEncode implements gob.GobEncoder, encoding the elements of the array with encoding/gob.
+func (array *Array[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(array.array); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder, replacing the elements of the array with the decoded ones.
+// The equality function of the array is kept.
+func (array *Array[T]) GobDecode(data []byte) error {
+	var entries []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&entries); err != nil {
+		return err
+	}
+
+	if entries == nil {
+		entries = []T{}
+	}
+
+	array.array = entries
+	return nil
+}
+
+// Value implements driver.Valuer, storing the array in a database as its JSON encoding.
+// A nil array is stored as NULL.
+func (array *Array[T]) Value() (driver.Value, error) {
+	if array == nil {
+		return nil, nil
+	}
+
+	data, err := array.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// Scan implements sql.Scanner, reading an array stored by Value from a JSON text or blob column.
+// A NULL column results in an empty array.
+func (array *Array[T]) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		array.array = []T{}
+		return nil
+	case []byte:
+		return array.UnmarshalJSON(v)
+	case string:
+		return array.UnmarshalJSON([]byte(v))
+	}
+
+	return fmt.Errorf("array: cannot scan %T into an Array", src)
+}
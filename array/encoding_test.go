@@ -0,0 +1,169 @@
+package array
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// The encodings must be reachable through a pointer, the way arrays are passed around.
+var (
+	_ json.Marshaler           = (*Array[int])(nil)
+	_ json.Unmarshaler         = (*Array[int])(nil)
+	_ encoding.TextMarshaler   = (*Array[int])(nil)
+	_ encoding.TextUnmarshaler = (*Array[int])(nil)
+	_ gob.GobEncoder           = (*Array[int])(nil)
+	_ gob.GobDecoder           = (*Array[int])(nil)
+	_ driver.Valuer            = (*Array[int])(nil)
+	_ sql.Scanner              = (*Array[int])(nil)
+)
+
+type encodedPoint struct {
+	X, Y int
+}
+
+type encodedDocument struct {
+	Title string
+	Tags  *Array[string]
+}
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  *Array[int]
+		want string
+	}{
+		{"elements", NewWithEntries([]int{1, 2, 3}), "[1,2,3]"},
+		{"empty", New[int](), "[]"},
+		{"zero value", &Array[int]{}, "[]"},
+		{"nil", nil, "null"},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.arr)
+		if err != nil || string(data) != tt.want {
+			t.Errorf("%s: Marshal() = %s, %v, want %s", tt.name, data, err, tt.want)
+		}
+	}
+
+	doc := encodedDocument{Title: "notes", Tags: NewWithEntries([]string{"go", "js"})}
+
+	data, err := json.Marshal(doc)
+	if want := `{"Title":"notes","Tags":["go","js"]}`; err != nil || string(data) != want {
+		t.Fatalf("Marshal(struct) = %s, %v, want %s", data, err, want)
+	}
+
+	var decoded encodedDocument
+	if err := json.Unmarshal(data, &decoded); err != nil || !slices.Equal(decoded.Tags.array, []string{"go", "js"}) {
+		t.Errorf("Unmarshal(struct) = %v, %v, want the tags back", decoded.Tags, err)
+	}
+
+	kept := New[string]().SetEquality(strings.EqualFold)
+	if err := json.Unmarshal([]byte(`["A"]`), kept); err != nil || !kept.Includes("a") {
+		t.Errorf("Unmarshal lost the equality function: %v", err)
+	}
+
+	null := NewWithEntries([]int{1})
+	if err := json.Unmarshal([]byte(`null`), null); err != nil || null.array == nil || len(null.array) != 0 {
+		t.Errorf("Unmarshal(null) = %#v, %v, want an empty array", null.array, err)
+	}
+
+	if err := json.Unmarshal([]byte(`["a"]`), New[int]()); err == nil {
+		t.Error("Unmarshal of strings into an Array[int] succeeded")
+	}
+}
+
+func TestText(t *testing.T) {
+	arr := NewWithEntries([]encodedPoint{{1, 2}, {3, 4}})
+
+	text, err := arr.MarshalText()
+	if want := `[{"X":1,"Y":2},{"X":3,"Y":4}]`; err != nil || string(text) != want {
+		t.Fatalf("MarshalText() = %s, %v, want %s", text, err, want)
+	}
+
+	decoded := New[encodedPoint]()
+	if err := decoded.UnmarshalText(text); err != nil || !slices.Equal(decoded.array, arr.array) {
+		t.Errorf("UnmarshalText() = %v, %v, want %v", decoded.array, err, arr.array)
+	}
+
+	// A map key is encoded through MarshalText.
+	keyed, err := json.Marshal(map[*Array[int]]string{NewWithEntries([]int{1, 2}): "a"})
+	if want := `{"[1,2]":"a"}`; err != nil || string(keyed) != want {
+		t.Errorf("Marshal(map) = %s, %v, want %s", keyed, err, want)
+	}
+}
+
+func TestGob(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  *Array[encodedPoint]
+	}{
+		{"elements", NewWithEntries([]encodedPoint{{1, 2}, {3, 4}})},
+		{"empty", New[encodedPoint]()},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(tt.arr); err != nil {
+			t.Fatalf("%s: Encode() failed: %v", tt.name, err)
+		}
+
+		decoded := NewWithEntries([]encodedPoint{{9, 9}})
+		if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+			t.Fatalf("%s: Decode() failed: %v", tt.name, err)
+		}
+
+		if !slices.Equal(decoded.array, tt.arr.array) || decoded.array == nil {
+			t.Errorf("%s: gob round trip = %#v, want %#v", tt.name, decoded.array, tt.arr.array)
+		}
+	}
+
+	var buf bytes.Buffer
+	gob.NewEncoder(&buf).Encode(struct{ Items *Array[string] }{NewWithEntries([]string{"x"})})
+
+	var decoded struct{ Items *Array[string] }
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil || !slices.Equal(decoded.Items.array, []string{"x"}) {
+		t.Errorf("gob round trip of a struct field = %v, %v, want [x]", decoded.Items, err)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	arr := NewWithEntries([]string{"a", "b"})
+
+	// database/sql converts arguments with the default converter, which calls Value.
+	value, err := driver.DefaultParameterConverter.ConvertValue(arr)
+	if want := `["a","b"]`; err != nil || value != want {
+		t.Fatalf("ConvertValue() = %v, %v, want %s", value, err, want)
+	}
+
+	if value, err := (*Array[string])(nil).Value(); value != nil || err != nil {
+		t.Errorf("Value() of a nil array = %v, %v, want NULL", value, err)
+	}
+
+	tests := []struct {
+		name string
+		src  any
+		want []string
+	}{
+		{"text column", value, []string{"a", "b"}},
+		{"blob column", []byte(`["c"]`), []string{"c"}},
+		{"NULL", nil, []string{}},
+	}
+
+	for _, tt := range tests {
+		scanned := NewWithEntries([]string{"old"})
+		if err := scanned.Scan(tt.src); err != nil || !slices.Equal(scanned.array, tt.want) || scanned.array == nil {
+			t.Errorf("%s: Scan() = %#v, %v, want %#v", tt.name, scanned.array, err, tt.want)
+		}
+	}
+
+	if err := New[string]().Scan(42); err == nil {
+		t.Error("Scan of an int succeeded")
+	}
+}
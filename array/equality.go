@@ -0,0 +1,248 @@
+package array
+
+import "reflect"
+
+// Equality reports whether two elements should be considered equal.
+// It is used by Includes, IndexOf and LastIndexOf to compare the elements of an array against a search term.
+//
+// Example:
+//
+//	byName := func(a, b User) bool { return a.Name == b.Name }
+//	arr := array.NewWithEquality[User](byName, users...)
+type Equality[T any] func(a, b T) bool
+
+// SameValueZero compares two values the way the SameValueZero algorithm of JavaScript does,
+// and is the default equality of an array.
+// Values are compared with ==, except that NaN is considered equal to NaN.
+// Values that Go cannot compare with ==, such as slices, maps and functions (or structs and
+// interfaces holding them), are compared with reflect.DeepEqual instead of panicking.
+// The methods of an array work out how to compare its element type once per call rather than once per
+// element, so prefer them to calling SameValueZero in a loop.
+func SameValueZero[T any](a, b T) bool {
+	return sameValueZero[T]()(a, b)
+}
+
+// comparison is the way SameValueZero compares the values of a type.
+type comparison int
+
+const (
+	// compareEqual compares with ==, for types that can neither hold NaN nor panic when compared.
+	compareEqual comparison = iota
+	// compareFloat compares with ==, and considers NaN equal to NaN.
+	compareFloat
+	// compareReflect looks at the values with reflect, for interfaces, types Go cannot compare, and
+	// structs and arrays holding floats, whose NaN fields or elements == would never consider equal.
+	compareReflect
+)
+
+// comparisonOf returns the way SameValueZero compares the values of type T.
+func comparisonOf[T any]() comparison {
+	t := reflect.TypeFor[T]()
+
+	switch {
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		return compareFloat
+	case t.Comparable() && !holdsInterface(t) && !holdsFloat(t):
+		return compareEqual
+	}
+
+	return compareReflect
+}
+
+// sameValueZero returns SameValueZero for values of type T, resolving how to compare them up front,
+// so that comparable types other than floats and interfaces are compared with a plain ==.
+func sameValueZero[T any]() Equality[T] {
+	comparison := comparisonOf[T]()
+	if comparison == compareReflect {
+		return sameValueZeroReflect[T]
+	}
+
+	if equal, ok := typedEqual[T](); ok {
+		return equal
+	}
+
+	// T is a named or composite type, so == can only be reached through interfaces.
+	// Neither operand escapes, so the conversions do not allocate.
+	if comparison == compareFloat {
+		return func(a, b T) bool {
+			x, y := any(a), any(b)
+			return x == y || (x != x && y != y)
+		}
+	}
+
+	return func(a, b T) bool {
+		return any(a) == any(b)
+	}
+}
+
+// typedEqual returns SameValueZero for values of type T compared with a typed ==, and false if T is not
+// one of the predeclared types that compareEqual and compareFloat apply to.
+func typedEqual[T any]() (Equality[T], bool) {
+	var equal any
+
+	switch any(*new(T)).(type) {
+	case float32:
+		equal = Equality[float32](sameFloat[float32])
+	case float64:
+		equal = Equality[float64](sameFloat[float64])
+	case bool:
+		equal = Equality[bool](same[bool])
+	case string:
+		equal = Equality[string](same[string])
+	case int:
+		equal = Equality[int](same[int])
+	case int8:
+		equal = Equality[int8](same[int8])
+	case int16:
+		equal = Equality[int16](same[int16])
+	case int32:
+		equal = Equality[int32](same[int32])
+	case int64:
+		equal = Equality[int64](same[int64])
+	case uint:
+		equal = Equality[uint](same[uint])
+	case uint8:
+		equal = Equality[uint8](same[uint8])
+	case uint16:
+		equal = Equality[uint16](same[uint16])
+	case uint32:
+		equal = Equality[uint32](same[uint32])
+	case uint64:
+		equal = Equality[uint64](same[uint64])
+	case uintptr:
+		equal = Equality[uintptr](same[uintptr])
+	}
+
+	result, ok := equal.(Equality[T])
+	return result, ok
+}
+
+// same compares two values with ==.
+func same[C comparable](a, b C) bool {
+	return a == b
+}
+
+// sameFloat compares two floats with ==, and considers NaN equal to NaN.
+func sameFloat[F float32 | float64](a, b F) bool {
+	return a == b || (a != a && b != b)
+}
+
+// sameValueZeroReflect implements SameValueZero for interfaces, types that Go cannot compare with ==,
+// and structs and arrays holding floats, looking at the dynamic values of the operands.
+func sameValueZeroReflect[T any](a, b T) bool {
+	x, y := reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem()
+
+	if x.Comparable() && y.Comparable() {
+		return sameValueZeroValue(x, y)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// sameValueZeroValue compares two values that Go can compare with ==, the way == would except that
+// NaN is considered equal to NaN, wherever it appears in the values.
+func sameValueZeroValue(x, y reflect.Value) bool {
+	if x.Kind() == reflect.Interface {
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() && y.IsNil()
+		}
+
+		x, y = x.Elem(), y.Elem()
+		if x.Type() != y.Type() {
+			return false
+		}
+	}
+
+	switch x.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return sameFloat(x.Float(), y.Float())
+	case reflect.Array:
+		for i := 0; i < x.Len(); i++ {
+			if !sameValueZeroValue(x.Index(i), y.Index(i)) {
+				return false
+			}
+		}
+
+		return true
+	case reflect.Struct:
+		for i := 0; i < x.NumField(); i++ {
+			if !sameValueZeroValue(x.Field(i), y.Field(i)) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return x.Equal(y)
+}
+
+// DeepEqual compares two values with reflect.DeepEqual.
+// It is useful for arrays of slices, maps and structs, where two values with the same contents
+// should be considered equal.
+func DeepEqual[T any](a, b T) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// equality returns the equality function of the array, or SameValueZero resolved for its element type
+// when none was set. Callers comparing many elements fetch it once, before they loop over them.
+func (array *Array[T]) equality() Equality[T] {
+	if array.equal == nil {
+		return sameValueZero[T]()
+	}
+
+	return array.equal
+}
+
+// isNaN reports whether the value, or the value held by an interface, is a floating-point NaN.
+func isNaN(v reflect.Value) bool {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		return f != f
+	}
+
+	return false
+}
+
+// holdsFloat reports whether the type is a struct or an array with floats among its fields or elements,
+// which == compares one by one, so that a NaN inside makes the whole value unequal to itself.
+func holdsFloat(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Array:
+		return holdsFloat(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if holdsFloat(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// holdsInterface reports whether values of the type may hold an interface, whose dynamic value decides
+// whether == panics and whether it is a NaN.
+func holdsInterface(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Array:
+		return holdsInterface(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if holdsInterface(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package array
+
+import (
+	"math"
+	"testing"
+)
+
+type celsius float64
+
+type point struct {
+	X, Y int
+}
+
+type tagged struct {
+	Name  string
+	Value any
+}
+
+type measurement struct {
+	Label string
+	Value float64
+}
+
+func TestSameValueZero(t *testing.T) {
+	nan, negZero := math.NaN(), math.Copysign(0, -1)
+
+	tests := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"ints", SameValueZero(1, 1), true},
+		{"different ints", SameValueZero(1, 2), false},
+		{"strings", SameValueZero("a", "a"), true},
+		{"NaN", SameValueZero(nan, nan), true},
+		{"NaN and 0", SameValueZero(nan, 0), false},
+		{"-0 and +0", SameValueZero(negZero, 0), true},
+		{"named float NaN", SameValueZero(celsius(nan), celsius(nan)), true},
+		{"float32 NaN", SameValueZero(float32(nan), float32(nan)), true},
+		{"structs", SameValueZero(point{1, 2}, point{1, 2}), true},
+		{"any holding NaN", SameValueZero[any](nan, nan), true},
+		{"any holding different types", SameValueZero[any](1, "1"), false},
+		{"any holding slices", SameValueZero[any]([]int{1}, []int{1}), true},
+		{"slices", SameValueZero([]int{1, 2}, []int{1, 2}), true},
+		{"structs holding slices", SameValueZero(tagged{"a", []int{1}}, tagged{"a", []int{1}}), true},
+		{"arrays holding NaN inside interfaces", SameValueZero([1]any{nan}, [1]any{nan}), true},
+		{"arrays holding NaN", SameValueZero([2]float64{1, nan}, [2]float64{1, nan}), true},
+		{"arrays holding -0 and +0", SameValueZero([1]float64{negZero}, [1]float64{0}), true},
+		{"arrays holding different floats", SameValueZero([2]float64{1, nan}, [2]float64{2, nan}), false},
+		{"structs holding NaN", SameValueZero(measurement{"a", nan}, measurement{"a", nan}), true},
+		{"structs holding NaN and different fields", SameValueZero(measurement{"a", nan}, measurement{"b", nan}), false},
+		{"structs holding NaN and a number", SameValueZero(measurement{"a", nan}, measurement{"a", 1}), false},
+		{"nested structs holding NaN", SameValueZero([1]measurement{{"a", nan}}, [1]measurement{{"a", nan}}), true},
+		{"any holding structs holding NaN", SameValueZero[any](measurement{"a", nan}, measurement{"a", nan}), true},
+		{"structs holding nil interfaces", SameValueZero(tagged{"a", nil}, tagged{"a", nil}), true},
+		{"structs holding nil and a value", SameValueZero(tagged{"a", nil}, tagged{"a", 0}), false},
+		{"structs holding different dynamic types", SameValueZero(tagged{"a", 1}, tagged{"a", int64(1)}), false},
+		{"named ints", SameValueZero(celsius(1), celsius(1)), true},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: SameValueZero = %t, want %t", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+// typed reports whether SameValueZero compares values of type T with a typed ==.
+func typed[T any]() bool {
+	_, ok := typedEqual[T]()
+	return ok
+}
+
+func TestComparisonOf(t *testing.T) {
+	tests := []struct {
+		name             string
+		got, want        comparison
+		typed, wantTyped bool
+	}{
+		{"int", comparisonOf[int](), compareEqual, typed[int](), true},
+		{"string", comparisonOf[string](), compareEqual, typed[string](), true},
+		{"float64", comparisonOf[float64](), compareFloat, typed[float64](), true},
+		{"float32", comparisonOf[float32](), compareFloat, typed[float32](), true},
+		{"named float", comparisonOf[celsius](), compareFloat, typed[celsius](), false},
+		{"struct", comparisonOf[point](), compareEqual, typed[point](), false},
+		{"struct holding a float", comparisonOf[measurement](), compareReflect, typed[measurement](), false},
+		{"array of floats", comparisonOf[[2]float64](), compareReflect, typed[[2]float64](), false},
+		{"struct holding an interface", comparisonOf[tagged](), compareReflect, typed[tagged](), false},
+		{"slice", comparisonOf[[]int](), compareReflect, typed[[]int](), false},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want || tt.typed != tt.wantTyped {
+			t.Errorf("%s: compared as %d, typed %t, want %d, typed %t", tt.name, tt.got, tt.typed, tt.want, tt.wantTyped)
+		}
+	}
+}
+
+func TestUniqueSameValueZero(t *testing.T) {
+	arr := NewWithEntries([]float64{math.NaN(), 0, math.Copysign(0, -1), math.NaN(), 1})
+
+	if got := arr.Unique(); len(got.array) != 3 {
+		t.Errorf("Unique() = %v, want [NaN 0 1]", got.array)
+	}
+
+	nan := math.NaN()
+	structs := NewWithEntries([]measurement{{"a", nan}, {"a", 1}, {"a", nan}, {"b", nan}})
+
+	if got := structs.Unique(); len(got.array) != 3 || !structs.Includes(measurement{"b", nan}) {
+		t.Errorf("Unique() of structs holding NaN = %v, want [{a NaN} {a 1} {b NaN}]", got.array)
+	}
+
+	boxed := NewWithEntries([]any{measurement{"a", nan}, measurement{"a", nan}, 1})
+	if got := boxed.Unique(); len(got.array) != 2 {
+		t.Errorf("Unique() of structs holding NaN inside any = %v, want [{a NaN} 1]", got.array)
+	}
+}
+
+func BenchmarkIndexOfInt(b *testing.B) {
+	arr := NewWithEntries(make([]int, 1000))
+	arr.Push(1)
+
+	for i := 0; i < b.N; i++ {
+		arr.IndexOf(1)
+	}
+}
+
+func BenchmarkIndexOfAny(b *testing.B) {
+	arr := NewWithEntries(make([]any, 1000))
+	arr.Push(1)
+
+	for i := 0; i < b.N; i++ {
+		arr.IndexOf(1)
+	}
+}
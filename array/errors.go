@@ -0,0 +1,145 @@
+package array
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+var (
+	// ErrFlatten is returned when flattening an array would produce an element that is not of the element type
+	// of the result, such as flattening an Array[[]int] into ints, or a nested list left over by FlatValues.
+	ErrFlatten = errors.New("array: cannot flatten element")
+
+	// ErrElementType is wrapped by the errors returned when an element is not of the element type of the array,
+	// such as an element of the source of From or FromAsync, or of a slice being flattened into an Array[T].
+	ErrElementType = errors.New("array: element has the wrong type")
+
+	// ErrNotIterable is returned by From and FromAsync for a source that is neither iterable nor array-like.
+	ErrNotIterable = errors.New("array: source is not iterable")
+
+	// ErrIndexOutOfRange is wrapped by the RangeError returned for an index outside the bounds of an array.
+	ErrIndexOutOfRange = errors.New("array: index out of range")
+
+	// ErrInvalidLength is wrapped by the RangeError returned for an invalid length or count,
+	// such as a negative delete count for Splice or a size less than 1 for Chunk.
+	ErrInvalidLength = errors.New("array: invalid length")
+)
+
+// RangeError is the error returned when an index or length passed to an array method is out of range,
+// the equivalent of the RangeError thrown by JavaScript. It wraps ErrIndexOutOfRange or ErrInvalidLength,
+// so it can be tested for with errors.Is, and inspected with errors.As.
+//
+// Example:
+//
+//	_, err := arr.With(10, 0)
+//	var rangeErr *array.RangeError
+//	if errors.As(err, &rangeErr) {
+//		fmt.Println(rangeErr.Index, rangeErr.Length)
+//	}
+type RangeError struct {
+	// Op is the name of the method that failed.
+	Op string
+	// Index is the index that was out of range, for an error wrapping ErrIndexOutOfRange.
+	Index int
+	// Count is the length or count that was invalid, for an error wrapping ErrInvalidLength.
+	Count int
+	// Length is the length of the array at the time of the call.
+	Length int
+	// Err is ErrIndexOutOfRange or ErrInvalidLength.
+	Err error
+}
+
+// Error returns a description of the error, such as "array: With: index 10 out of range for length 3"
+// or "array: Splice: invalid count -1".
+func (err *RangeError) Error() string {
+	if errors.Is(err.Err, ErrInvalidLength) {
+		return fmt.Sprintf("array: %s: invalid count %d", err.Op, err.Count)
+	}
+
+	return fmt.Sprintf("array: %s: index %d out of range for length %d", err.Op, err.Index, err.Length)
+}
+
+// Unwrap returns ErrIndexOutOfRange or ErrInvalidLength.
+func (err *RangeError) Unwrap() error {
+	return err.Err
+}
+
+// indexError returns a RangeError for an index out of range.
+func indexError(op string, index, length int) *RangeError {
+	return &RangeError{
+		Op:     op,
+		Index:  index,
+		Length: length,
+		Err:    ErrIndexOutOfRange,
+	}
+}
+
+// lengthError returns a RangeError for an invalid length or count.
+func lengthError(op string, count, length int) *RangeError {
+	return &RangeError{
+		Op:     op,
+		Count:  count,
+		Length: length,
+		Err:    ErrInvalidLength,
+	}
+}
+
+// Policy decides what the JavaScript-compatible methods of Array do when they are given an argument that
+// JavaScript would silently clamp or ignore, such as an index past the end of the array passed to Slice,
+// Fill or Splice, or a negative delete count. Reading from an empty array or past its end, as Pop, Shift
+// and At do, is not an error in JavaScript and is never reported. Whatever the policy, the methods of
+// Array.Checked return an error instead.
+type Policy int32
+
+const (
+	// PolicyClamp silently clamps indices to the bounds of the array, as JavaScript does. It is the default.
+	PolicyClamp Policy = iota
+	// PolicyLog logs the *RangeError at the WARNING level through the Logger set with SetLogger,
+	// then clamps like PolicyClamp.
+	PolicyLog
+	// PolicyPanic panics with a *RangeError.
+	PolicyPanic
+)
+
+// policy is the current Policy, read on every call to a method that may go out of range.
+var policy atomic.Int32
+
+// SetPolicy sets the policy for out-of-range indices and lengths in the JavaScript-compatible methods of
+// Array, such as At, Fill, Slice and Splice, and returns the previous policy. It is safe to call at any time
+// from any goroutine, but is meant to be set once at startup, for example to PolicyPanic in tests to catch
+// index bugs that JavaScript semantics would hide.
+//
+// Example:
+//
+//	array.SetPolicy(array.PolicyPanic)
+//	arr := array.NewWithEntries([]int{1, 2, 3})
+//	arr.Slice(0, 5) // panics with "array: Slice: index 5 out of range for length 3"
+func SetPolicy(p Policy) Policy {
+	return Policy(policy.Swap(int32(p)))
+}
+
+// CurrentPolicy returns the current policy for out-of-range indices and lengths.
+func CurrentPolicy() Policy {
+	return Policy(policy.Load())
+}
+
+// reporting reports whether out-of-range arguments have to be looked for at all, so that the default
+// PolicyClamp costs a single atomic load.
+func reporting() bool {
+	return policy.Load() != int32(PolicyClamp)
+}
+
+// report applies the current policy to an error found by a JavaScript-compatible method, which then carries on.
+func report(err error) {
+	if err == nil {
+		return
+	}
+
+	switch Policy(policy.Load()) {
+	case PolicyLog:
+		log(WARNING, err.Error())
+	case PolicyPanic:
+		panic(err)
+	}
+}
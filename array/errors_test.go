@@ -0,0 +1,95 @@
+package array
+
+import (
+	"errors"
+	"testing"
+)
+
+// withPolicy runs fn under the given policy, restoring the previous policy afterwards.
+func withPolicy(t *testing.T, p Policy, fn func()) {
+	t.Helper()
+
+	previous := SetPolicy(p)
+	defer SetPolicy(previous)
+
+	fn()
+}
+
+func TestPolicyPanicIgnoresEmptyReads(t *testing.T) {
+	withPolicy(t, PolicyPanic, func() {
+		arr := New[int]()
+
+		arr.Pop()
+		arr.Shift()
+		arr.At(5)
+		arr.Reduce(func(accumulator, value, index int, array *Array[int]) int { return accumulator + value })
+	})
+}
+
+func TestPolicyPanicSpliceToEnd(t *testing.T) {
+	withPolicy(t, PolicyPanic, func() {
+		arr := NewWithEntries([]int{1, 2, 3})
+
+		if removed := arr.Splice(1, arr.Length()); removed.Length() != 2 || arr.Length() != 1 {
+			t.Errorf("Splice(1, 3) removed %v leaving %v", removed.array, arr.array)
+		}
+	})
+}
+
+func TestPolicyPanicReportsClampedArguments(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(arr *Array[int])
+		err  error
+	}{
+		{"Slice", func(arr *Array[int]) { arr.Slice(0, 5) }, ErrIndexOutOfRange},
+		{"Fill", func(arr *Array[int]) { arr.Fill(0, -4) }, ErrIndexOutOfRange},
+		{"Splice", func(arr *Array[int]) { arr.Splice(0, -1) }, ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		withPolicy(t, PolicyPanic, func() {
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, tt.err) {
+					t.Errorf("%s panicked with %v, want %v", tt.name, err, tt.err)
+				}
+			}()
+
+			tt.fn(NewWithEntries([]int{1, 2, 3}))
+		})
+	}
+}
+
+func TestPolicyLogWarnsThroughLogger(t *testing.T) {
+	var arr *Array[int]
+
+	logged := captureLog(t, WARNING, func() {
+		withPolicy(t, PolicyLog, func() {
+			arr = NewWithEntries([]int{1, 2, 3})
+			arr.Fill(0, 1, 10)
+			arr.At(10)
+		})
+	})
+
+	if want := "array: Fill: index 10 out of range for length 3\n"; logged != want {
+		t.Errorf("logged %q, want %q", logged, want)
+	}
+
+	if got := arr.Slice(0); got[1] != 0 || got[2] != 0 {
+		t.Errorf("Fill(0, 1, 10) under PolicyLog = %v, want the clamped fill [1 0 0]", got)
+	}
+}
+
+func TestRangeErrorMessage(t *testing.T) {
+	_, err := NewWithEntries([]int{1, 2, 3}).Checked().Splice(0, -1)
+
+	var rangeErr *RangeError
+	if !errors.As(err, &rangeErr) || rangeErr.Count != -1 {
+		t.Fatalf("Checked().Splice(0, -1) error = %v, want a RangeError with Count -1", err)
+	}
+
+	if got, want := err.Error(), "array: Splice: invalid count -1"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,369 @@
+package array
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+)
+
+// Producer is an asynchronous source of values for FromAsync. It calls yield with each value it produces,
+// stopping when yield returns false or the context is done, and returns any error it runs into.
+type Producer[T any] func(ctx context.Context, yield func(value T) bool) error
+
+// arrayLike is implemented by the arrays of this package and the typed arrays, which FromAsync and From
+// read by index like the array-like objects of JavaScript.
+type arrayLike[T any] interface {
+	Length() int
+	At(index int) T
+}
+
+// From creates a new array from an iterable or array-like source, like Array.from.
+// The source can be:
+//   - a slice or Go array, whose elements are copied;
+//   - a string, split into its code points like the string iterator of JavaScript, so that
+//     From[string]("héllo") has 5 elements whatever their length in bytes;
+//   - a channel, read until it is closed;
+//   - a map, read as Pair elements holding each key and value, ordered by key when the keys are numbers
+//     or strings; the element type must be a Pair of the key and value types, or any;
+//   - an iter.Seq or iter.Seq2 function, or a value with a Seq method such as an iterator.Iterator,
+//     with iter.Seq2 values read as Pair elements like maps;
+//   - an array-like value with Length and At methods, such as an Array, Deque, ImmutableArray, SparseArray
+//     or typed array, where the holes of a SparseArray become zero values.
+//
+// The optional mapFn is called with every element and its index, and its results are stored instead,
+// like the mapFn argument of Array.from. To map into a different type, use the package-level Map function
+// on the result.
+// If the source is none of the above, it returns an error wrapping ErrNotIterable; if an element is not of
+// type T, it returns an error wrapping ErrElementType.
+//
+// Example:
+//
+//	chars, _ := array.From[string]("héllo")
+//	// chars is now an array of type []string with elements "h", "é", "l", "l", "o"
+//
+//	squares, _ := array.From([]int{1, 2, 3}, func(value int, _ int) int {
+//		return value * value
+//	})
+//	// squares is now an array of type []int with elements 1, 4, 9
+func From[T any](source any, mapFn ...func(value T, index int) T) (*Array[T], error) {
+	result := []T{}
+
+	err := each(source, func(value T) bool {
+		if len(mapFn) > 0 && mapFn[0] != nil {
+			value = mapFn[0](value, len(result))
+		}
+
+		result = append(result, value)
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Array[T]{
+		array: result,
+	}, nil
+}
+
+// FromAsync creates a new array from an asynchronous source, like Array.fromAsync. The source can be a
+// channel, read until it is closed, or a Producer (or a function with the same signature), along with
+// every source accepted by From. The optional mapFn is called with every element and its index, and may
+// fail, like the mapping functions of ParallelMap.
+//
+// If the context is done before the source is used up, it returns the cause of the context; if the source
+// or mapFn fails, it returns their error. In both cases the elements read so far are discarded.
+//
+// Example:
+//
+//	results, err := array.FromAsync[Result](ctx, resultsChan)
+//
+//	pages, err := array.FromAsync(ctx, array.Producer[Page](func(ctx context.Context, yield func(Page) bool) error {
+//		for url := first; url != ""; {
+//			page, err := fetch(ctx, url)
+//			if err != nil {
+//				return err
+//			}
+//			if !yield(page) {
+//				return nil
+//			}
+//			url = page.Next
+//		}
+//		return nil
+//	}))
+func FromAsync[T any](ctx context.Context, source any, mapFn ...func(ctx context.Context, value T, index int) (T, error)) (*Array[T], error) {
+	var (
+		result = []T{}
+		mapErr error
+	)
+
+	yield := func(value T) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+
+		if len(mapFn) > 0 && mapFn[0] != nil {
+			if value, mapErr = mapFn[0](ctx, value, len(result)); mapErr != nil {
+				return false
+			}
+		}
+
+		result = append(result, value)
+		return true
+	}
+
+	var err error
+
+	switch s := source.(type) {
+	case Producer[T]:
+		err = s(ctx, yield)
+	case func(ctx context.Context, yield func(value T) bool) error:
+		err = s(ctx, yield)
+	case chan T:
+		err = receive(ctx, s, yield)
+	case <-chan T:
+		err = receive(ctx, s, yield)
+	default:
+		if rv := reflect.ValueOf(source); rv.Kind() == reflect.Chan && rv.Type().ChanDir()&reflect.RecvDir != 0 {
+			err = receiveValue(ctx, rv, yield)
+		} else {
+			err = each(source, yield)
+		}
+	}
+
+	switch {
+	case mapErr != nil:
+		return nil, mapErr
+	case err != nil:
+		return nil, err
+	case ctx.Err() != nil:
+		return nil, context.Cause(ctx)
+	}
+
+	return &Array[T]{
+		array: result,
+	}, nil
+}
+
+// receive reads values from the channel until it is closed, yield returns false or the context is done.
+func receive[T any](ctx context.Context, ch <-chan T, yield func(value T) bool) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case v, ok := <-ch:
+			if !ok || !yield(v) {
+				return nil
+			}
+		}
+	}
+}
+
+// receiveValue is receive for a channel whose element type is only known at run time.
+func receiveValue[T any](ctx context.Context, ch reflect.Value, yield func(value T) bool) error {
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+		{Dir: reflect.SelectRecv, Chan: ch},
+	}
+
+	for {
+		chosen, v, ok := reflect.Select(cases)
+		if chosen == 0 {
+			return context.Cause(ctx)
+		}
+
+		if !ok {
+			return nil
+		}
+
+		value, err := valueAs[T](v, ch.Type())
+		if err != nil {
+			return err
+		}
+
+		if !yield(value) {
+			return nil
+		}
+	}
+}
+
+// each calls yield with every element of the source, as described by From, until yield returns false.
+func each[T any](source any, yield func(value T) bool) error {
+	switch s := source.(type) {
+	case nil:
+		return fmt.Errorf("%w: nil", ErrNotIterable)
+	case []T:
+		for _, v := range s {
+			if !yield(v) {
+				break
+			}
+		}
+
+		return nil
+	case iter.Seq[T]:
+		for v := range s {
+			if !yield(v) {
+				break
+			}
+		}
+
+		return nil
+	case interface{ Seq() iter.Seq[T] }:
+		return each(s.Seq(), yield)
+	case arrayLike[T]:
+		for i := 0; i < s.Length(); i++ {
+			if !yield(s.At(i)) {
+				break
+			}
+		}
+
+		return nil
+	case chan T:
+		return receive(context.Background(), s, yield)
+	case <-chan T:
+		return receive(context.Background(), s, yield)
+	}
+
+	rv := reflect.ValueOf(source)
+	if rv.Kind() == reflect.Pointer && rv.Elem().Kind() == reflect.Array {
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		for _, r := range rv.String() {
+			value, err := valueAs[T](reflect.ValueOf(string(r)), rv.Type())
+			if err != nil {
+				return err
+			}
+
+			if !yield(value) {
+				break
+			}
+		}
+
+		return nil
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			value, err := valueAs[T](rv.Index(i), rv.Type())
+			if err != nil {
+				return err
+			}
+
+			if !yield(value) {
+				break
+			}
+		}
+
+		return nil
+	case reflect.Chan:
+		if rv.Type().ChanDir()&reflect.RecvDir != 0 {
+			return receiveValue(context.Background(), rv, yield)
+		}
+	case reflect.Map:
+		keys := rv.MapKeys()
+		slices.SortStableFunc(keys, compareKeys)
+
+		for _, k := range keys {
+			value, err := pairAs[T](k, rv.MapIndex(k), rv.Type())
+			if err != nil {
+				return err
+			}
+
+			if !yield(value) {
+				break
+			}
+		}
+
+		return nil
+	case reflect.Func:
+		if seq, ok := seqYield(rv.Type()); ok {
+			return eachSeq(rv, seq, yield)
+		}
+	}
+
+	return fmt.Errorf("%w: %T", ErrNotIterable, source)
+}
+
+// seqYield returns the type of the yield function of an iter.Seq or iter.Seq2 of any element types.
+func seqYield(t reflect.Type) (reflect.Type, bool) {
+	if t.NumIn() != 1 || t.NumOut() != 0 || t.In(0).Kind() != reflect.Func {
+		return nil, false
+	}
+
+	yield := t.In(0)
+	if yield.NumOut() != 1 || yield.Out(0).Kind() != reflect.Bool || yield.NumIn() < 1 || yield.NumIn() > 2 {
+		return nil, false
+	}
+
+	return yield, true
+}
+
+// eachSeq calls yield with every value of an iter.Seq or iter.Seq2 whose element types are only known at run time.
+func eachSeq[T any](seq reflect.Value, yieldType reflect.Type, yield func(value T) bool) error {
+	var err error
+
+	fn := reflect.MakeFunc(yieldType, func(args []reflect.Value) []reflect.Value {
+		var value T
+
+		if len(args) == 1 {
+			value, err = valueAs[T](args[0], seq.Type())
+		} else {
+			value, err = pairAs[T](args[0], args[1], seq.Type())
+		}
+
+		more := err == nil && yield(value)
+		return []reflect.Value{reflect.ValueOf(more).Convert(yieldType.Out(0))}
+	})
+
+	seq.Call([]reflect.Value{fn})
+	return err
+}
+
+// pairAs returns the key and value as a T, which must be a Pair of types they can be assigned to, or a type
+// that Pair[any, any] can be assigned to, such as any.
+func pairAs[T any](k, v reflect.Value, source reflect.Type) (T, error) {
+	var (
+		result = new(T)
+		target = reflect.ValueOf(result).Elem()
+	)
+
+	if target.Kind() == reflect.Struct && target.NumField() == 2 {
+		first, firstOK := assignable(k, target.Type().Field(0).Type)
+		second, secondOK := assignable(v, target.Type().Field(1).Type)
+
+		if firstOK && secondOK && target.Type().Field(0).Name == "First" && target.Type().Field(1).Name == "Second" {
+			target.Field(0).Set(first)
+			target.Field(1).Set(second)
+
+			return *result, nil
+		}
+	}
+
+	pair := reflect.ValueOf(Pair[any, any]{First: k.Interface(), Second: v.Interface()})
+	if pair.Type().AssignableTo(target.Type()) {
+		target.Set(pair)
+		return *result, nil
+	}
+
+	return *result, fmt.Errorf("%w: pair of %s and %s in %s is not %s", ErrElementType, k.Type(), v.Type(), source, target.Type())
+}
+
+// compareKeys orders map keys that are numbers or strings, and leaves any other keys in map order.
+func compareKeys(a, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(a.Float(), b.Float())
+	case reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	}
+
+	return 0
+}
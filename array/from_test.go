@@ -0,0 +1,273 @@
+package array
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+// seqOf has a Seq method, like an iterator.Iterator.
+type seqOf[T any] []T
+
+func (s seqOf[T]) Seq() iter.Seq[T] { return slices.Values(s) }
+
+type label string
+
+// closed returns a closed channel holding the values.
+func closed[T any](values ...T) chan T {
+	ch := make(chan T, len(values))
+	for _, v := range values {
+		ch <- v
+	}
+
+	close(ch)
+	return ch
+}
+
+func TestFrom(t *testing.T) {
+	holey := NewSparse[int](3)
+	holey.Set(1, 2)
+
+	tests := []struct {
+		name   string
+		source any
+		want   string
+	}{
+		{"slice", []int{1, 2, 3}, "[1 2 3]"},
+		{"Go array", [3]int{1, 2, 3}, "[1 2 3]"},
+		{"pointer to a Go array", &[2]int{1, 2}, "[1 2]"},
+		{"slice of any", []any{1, 2}, "[1 2]"},
+		{"channel", closed(1, 2, 3), "[1 2 3]"},
+		{"receive-only channel", (<-chan int)(closed(4, 5)), "[4 5]"},
+		{"channel of any", closed[any](6, 7), "[6 7]"},
+		{"iter.Seq", slices.Values([]int{1, 2}), "[1 2]"},
+		{"Seq method", seqOf[int]{3, 4}, "[3 4]"},
+		{"Array", NewWithEntries([]int{1, 2}), "[1 2]"},
+		{"Deque", NewDequeWithEntries([]int{1, 2}), "[1 2]"},
+		{"SparseArray holes become zero values", holey, "[0 2 0]"},
+		{"empty slice", []int{}, "[]"},
+	}
+
+	for _, tt := range tests {
+		got, err := From[int](tt.source)
+		if err != nil {
+			t.Errorf("%s: From failed: %v", tt.name, err)
+			continue
+		}
+
+		if s := fmt.Sprint(got.array); s != tt.want {
+			t.Errorf("%s: From() = %s, want %s", tt.name, s, tt.want)
+		}
+	}
+}
+
+func TestFromString(t *testing.T) {
+	tests := []struct {
+		source any
+		want   []string
+	}{
+		{"héllo", []string{"h", "é", "l", "l", "o"}},
+		{"a😀b", []string{"a", "😀", "b"}},
+		{"", []string{}},
+		{label("ok"), []string{"o", "k"}},
+	}
+
+	for _, tt := range tests {
+		got, err := From[string](tt.source)
+		if err != nil || !slices.Equal(got.array, tt.want) {
+			t.Errorf("From(%q) = %q, %v, want %q", tt.source, got.array, err, tt.want)
+		}
+	}
+
+	if _, err := From[int]("abc"); !errors.Is(err, ErrElementType) {
+		t.Errorf("From[int] of a string returned %v, want ErrElementType", err)
+	}
+}
+
+func TestFromPairs(t *testing.T) {
+	m := map[string]int{"c": 3, "a": 1, "b": 2}
+
+	pairs, err := From[Pair[string, int]](m)
+	if want := []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}; err != nil || !slices.Equal(pairs.array, want) {
+		t.Errorf("From(map) = %v, %v, want %v", pairs.array, err, want)
+	}
+
+	numbers, err := From[any](map[int]string{10: "ten", 2: "two"})
+	if want := []any{Pair[any, any]{2, "two"}, Pair[any, any]{10, "ten"}}; err != nil || !slices.Equal(numbers.array, want) {
+		t.Errorf("From[any](map) = %v, %v, want %v", numbers.array, err, want)
+	}
+
+	seq2, err := From[Pair[int, string]](slices.All([]string{"x", "y"}))
+	if want := []Pair[int, string]{{0, "x"}, {1, "y"}}; err != nil || !slices.Equal(seq2.array, want) {
+		t.Errorf("From(iter.Seq2) = %v, %v, want %v", seq2.array, err, want)
+	}
+
+	if _, err := From[Pair[string, string]](m); !errors.Is(err, ErrElementType) {
+		t.Errorf("From of a map into mismatched pairs returned %v, want ErrElementType", err)
+	}
+
+	keys, err := From[string](maps.Keys(map[string]bool{"only": true}))
+	if err != nil || !slices.Equal(keys.array, []string{"only"}) {
+		t.Errorf("From(maps.Keys) = %v, %v, want [only]", keys.array, err)
+	}
+}
+
+func TestFromErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		source any
+		want   error
+	}{
+		{"nil", nil, ErrNotIterable},
+		{"number", 42, ErrNotIterable},
+		{"struct", struct{}{}, ErrNotIterable},
+		{"send-only channel", make(chan<- int), ErrNotIterable},
+		{"func that is not a sequence", func() {}, ErrNotIterable},
+		{"slice of another type", []string{"a"}, ErrElementType},
+		{"slice of any holding another type", []any{1, "two"}, ErrElementType},
+		{"sequence of another type", slices.Values([]string{"a"}), ErrElementType},
+	}
+
+	for _, tt := range tests {
+		if got, err := From[int](tt.source); !errors.Is(err, tt.want) || got != nil {
+			t.Errorf("%s: From() = %v, %v, want %v", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestFromMapFn(t *testing.T) {
+	var indices []int
+
+	got, err := From("abc", func(value string, index int) string {
+		indices = append(indices, index)
+		return value + value
+	})
+
+	if err != nil || !slices.Equal(got.array, []string{"aa", "bb", "cc"}) {
+		t.Errorf("From with mapFn = %v, %v, want [aa bb cc]", got.array, err)
+	}
+
+	if !slices.Equal(indices, []int{0, 1, 2}) {
+		t.Errorf("mapFn got indices %v, want [0 1 2]", indices)
+	}
+
+	if got, err := From[int]([]int{1, 2}, nil); err != nil || !slices.Equal(got.array, []int{1, 2}) {
+		t.Errorf("From with a nil mapFn = %v, %v, want [1 2]", got.array, err)
+	}
+}
+
+func TestFromAsync(t *testing.T) {
+	ctx := context.Background()
+
+	produced := Producer[int](func(ctx context.Context, yield func(int) bool) error {
+		for i := 1; i <= 5; i++ {
+			if !yield(i) {
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	tests := []struct {
+		name   string
+		source any
+		want   string
+	}{
+		{"channel", closed(1, 2, 3), "[1 2 3]"},
+		{"channel of any", closed[any](4, 5), "[4 5]"},
+		{"Producer", produced, "[1 2 3 4 5]"},
+		{"producer function", (func(ctx context.Context, yield func(int) bool) error)(produced), "[1 2 3 4 5]"},
+		{"slice", []int{6, 7}, "[6 7]"},
+	}
+
+	for _, tt := range tests {
+		got, err := FromAsync[int](ctx, tt.source)
+		if err != nil || fmt.Sprint(got.array) != tt.want {
+			t.Errorf("%s: FromAsync() = %v, %v, want %s", tt.name, got, err, tt.want)
+		}
+	}
+
+	doubled, err := FromAsync(ctx, produced, func(ctx context.Context, value, index int) (int, error) {
+		return value * index, nil
+	})
+
+	if err != nil || !slices.Equal(doubled.array, []int{0, 2, 6, 12, 20}) {
+		t.Errorf("FromAsync with mapFn = %v, %v, want [0 2 6 12 20]", doubled.array, err)
+	}
+}
+
+func TestFromAsyncErrors(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	_, err := FromAsync(context.Background(), closed(1, 2, 3), func(ctx context.Context, value, index int) (int, error) {
+		if value == 2 {
+			return 0, errFailed
+		}
+
+		return value, nil
+	})
+
+	if !errors.Is(err, errFailed) {
+		t.Errorf("FromAsync with a failing mapFn returned %v, want its error", err)
+	}
+
+	got, err := FromAsync[int](context.Background(), Producer[int](func(ctx context.Context, yield func(int) bool) error {
+		yield(1)
+		return errFailed
+	}))
+
+	if !errors.Is(err, errFailed) || got != nil {
+		t.Errorf("FromAsync with a failing Producer = %v, %v, want nil and its error", got, err)
+	}
+}
+
+func TestFromAsyncCancel(t *testing.T) {
+	errStop := errors.New("stopped")
+
+	// A channel that is never closed is read until the context is cancelled.
+	ctx, cancel := context.WithCancelCause(context.Background())
+	open := make(chan int, 1)
+	open <- 1
+
+	time.AfterFunc(10*time.Millisecond, func() { cancel(errStop) })
+
+	got, err := FromAsync[int](ctx, open)
+	if !errors.Is(err, errStop) || got != nil {
+		t.Errorf("FromAsync of an open channel = %v, %v, want nil and the cause of the context", got, err)
+	}
+
+	// The same goes for a channel whose element type is only known at run time.
+	ctx, cancel = context.WithCancelCause(context.Background())
+	time.AfterFunc(10*time.Millisecond, func() { cancel(errStop) })
+
+	if _, err := FromAsync[any](ctx, make(chan int)); !errors.Is(err, errStop) {
+		t.Errorf("FromAsync of an open chan int into any returned %v, want the cause of the context", err)
+	}
+
+	// A Producer that ignores the context is stopped by yield returning false.
+	ctx, cancel = context.WithCancelCause(context.Background())
+	calls := 0
+
+	_, err = FromAsync[int](ctx, Producer[int](func(ctx context.Context, yield func(int) bool) error {
+		for i := 0; ; i++ {
+			calls++
+			if i == 3 {
+				cancel(errStop)
+			}
+
+			if !yield(i) {
+				return nil
+			}
+		}
+	}))
+
+	if !errors.Is(err, errStop) || calls != 4 {
+		t.Errorf("FromAsync of a cancelled Producer returned %v after %d values, want the cause after 4", err, calls)
+	}
+}
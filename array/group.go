@@ -0,0 +1,175 @@
+package array
+
+import "iter"
+
+// OrderedMap is a read-only map that remembers the order in which its keys were first inserted,
+// like a JavaScript Map. It is returned by GroupBy, CountBy and IndexBy so that iterating over
+// the result visits the keys in first-seen order, as Object.groupBy and Map.groupBy do.
+type OrderedMap[K comparable, V any] struct {
+	keys   []K
+	values map[K]V
+}
+
+// newOrderedMap returns a new empty ordered map.
+func newOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		values: map[K]V{},
+	}
+}
+
+// Entries returns an iterator over the key/value pairs of the map, in insertion order.
+//
+// Example:
+//
+//	for key, group := range array.GroupBy(arr, byType).Entries() {
+//		fmt.Println(key, group.Length())
+//	}
+func (m *OrderedMap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range m.keys {
+			if !yield(k, m.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// ForEach calls the provided function once for each key/value pair of the map, in insertion order.
+func (m *OrderedMap[K, V]) ForEach(fn func(value V, key K)) {
+	for _, k := range m.keys {
+		fn(m.values[k], k)
+	}
+}
+
+// Get returns the value stored for the given key, and whether the key is present in the map.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.values[key]
+
+	return v, ok
+}
+
+// Has reports whether the given key is present in the map.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.values[key]
+
+	return ok
+}
+
+// Keys returns an iterator over the keys of the map, in insertion order.
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, k := range m.keys {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Size returns the number of keys in the map.
+func (m *OrderedMap[K, V]) Size() int {
+	return len(m.keys)
+}
+
+// Values returns an iterator over the values of the map, in the insertion order of their keys.
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, k := range m.keys {
+			if !yield(m.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// update calls fn with the current value for the key, or a zero value if the key is new,
+// and stores the result. New keys are appended to the insertion order.
+func (m *OrderedMap[K, V]) update(key K, fn func(current V) V) {
+	current, ok := m.values[key]
+	if !ok {
+		m.keys = append(m.keys, key)
+	}
+
+	m.values[key] = fn(current)
+}
+
+// GroupBy groups the elements of the given array by the key returned by the provided function,
+// the equivalent of Map.groupBy. Each group is a new array holding the elements with that key in
+// their original order, and the groups are ordered by the first occurrence of their key.
+// The callback function takes the element value and its index.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{1, 2, 3, 4, 5})
+//	groups := array.GroupBy(arr, func(value int, _ int) string {
+//		if value%2 == 0 {
+//			return "even"
+//		}
+//		return "odd"
+//	})
+//	// groups is now {"odd": [1, 3, 5], "even": [2, 4]}
+func GroupBy[T any, K comparable](array *Array[T], fn func(value T, index int) K) *OrderedMap[K, *Array[T]] {
+	groups := newOrderedMap[K, *Array[T]]()
+
+	array.ForEach(func(value T, index int, _ *Array[T]) {
+		groups.update(fn(value, index), func(group *Array[T]) *Array[T] {
+			if group == nil {
+				group = New[T]().SetEquality(array.equal)
+			}
+
+			group.Push(value)
+			return group
+		})
+	})
+
+	return groups
+}
+
+// Partition splits the elements of the given array into those that pass the test implemented by the
+// provided function and those that do not, keeping their original order in both arrays.
+// The callback function takes the element value and its index.
+func Partition[T any](array *Array[T], fn func(value T, index int) bool) (*Array[T], *Array[T]) {
+	groups := GroupBy(array, fn)
+
+	pass, ok := groups.Get(true)
+	if !ok {
+		pass = New[T]().SetEquality(array.equal)
+	}
+
+	fail, ok := groups.Get(false)
+	if !ok {
+		fail = New[T]().SetEquality(array.equal)
+	}
+
+	return pass, fail
+}
+
+// CountBy counts the elements of the given array by the key returned by the provided function.
+// The counts are ordered by the first occurrence of their key.
+// The callback function takes the element value and its index.
+func CountBy[T any, K comparable](array *Array[T], fn func(value T, index int) K) *OrderedMap[K, int] {
+	counts := newOrderedMap[K, int]()
+
+	array.ForEach(func(value T, index int, _ *Array[T]) {
+		counts.update(fn(value, index), func(count int) int {
+			return count + 1
+		})
+	})
+
+	return counts
+}
+
+// IndexBy indexes the elements of the given array by the key returned by the provided function.
+// When several elements share a key, the last one wins, while the key keeps the position of its
+// first occurrence. The callback function takes the element value and its index.
+func IndexBy[T any, K comparable](array *Array[T], fn func(value T, index int) K) *OrderedMap[K, T] {
+	index := newOrderedMap[K, T]()
+
+	array.ForEach(func(value T, i int, _ *Array[T]) {
+		index.update(fn(value, i), func(T) T {
+			return value
+		})
+	})
+
+	return index
+}
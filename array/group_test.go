@@ -0,0 +1,189 @@
+package array
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// entries shows the entries of the map in order, as "key:value" separated by spaces.
+func entries[K comparable, V any](m *OrderedMap[K, V]) string {
+	parts := []string{}
+	for k, v := range m.Entries() {
+		parts = append(parts, fmt.Sprint(k, ":", v))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// parity is the key of an int: "even" or "odd".
+func parity(value, index int) string {
+	if value%2 == 0 {
+		return "even"
+	}
+
+	return "odd"
+}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		key    func(value, index int) string
+		want   string
+	}{
+		{"groups in first-seen order", []int{1, 2, 3, 4, 5}, parity, "odd:[1 3 5] even:[2 4]"},
+		{"first key seen later", []int{2, 1, 4}, parity, "even:[2 4] odd:[1]"},
+		{"by index", []int{5, 6, 7}, func(value, index int) string { return fmt.Sprint(index % 2) }, "0:[5 7] 1:[6]"},
+		{"single group", []int{1, 3}, parity, "odd:[1 3]"},
+		{"empty", nil, parity, ""},
+	}
+
+	for _, tt := range tests {
+		groups := GroupBy(NewWithEntries(tt.values), tt.key)
+
+		got := []string{}
+		for k, v := range groups.Entries() {
+			got = append(got, fmt.Sprint(k, ":", v.array))
+		}
+
+		if strings.Join(got, " ") != tt.want {
+			t.Errorf("%s: GroupBy() = %s, want %s", tt.name, strings.Join(got, " "), tt.want)
+		}
+	}
+
+	words := NewWithEntries([]string{"Go", "js", "GO"}).SetEquality(strings.EqualFold)
+	groups := GroupBy(words, func(value string, index int) int { return len(value) })
+
+	if group, _ := groups.Get(2); !group.Includes("gO") {
+		t.Error("the groups lost the equality function of the array")
+	}
+}
+
+func TestGroupCallbackIndex(t *testing.T) {
+	var indices []string
+
+	track := func(name string) func(value string, index int) string {
+		return func(value string, index int) string {
+			indices = append(indices, fmt.Sprint(name, index, value))
+			return value
+		}
+	}
+
+	arr := NewWithEntries([]string{"a", "b"})
+	GroupBy(arr, track("g"))
+	CountBy(arr, track("c"))
+	IndexBy(arr, track("i"))
+	Partition(arr, func(value string, index int) bool {
+		indices = append(indices, fmt.Sprint("p", index, value))
+		return true
+	})
+
+	if got, want := strings.Join(indices, " "), "g0a g1b c0a c1b i0a i1b p0a p1b"; got != want {
+		t.Errorf("callbacks were called with %s, want %s", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		values     []int
+		pass, fail string
+	}{
+		{[]int{1, 2, 3, 4, 5}, "[1 3 5]", "[2 4]"},
+		{[]int{2, 4}, "[]", "[2 4]"},
+		{[]int{1}, "[1]", "[]"},
+		{nil, "[]", "[]"},
+	}
+
+	for _, tt := range tests {
+		pass, fail := Partition(NewWithEntries(tt.values), func(value, index int) bool { return value%2 == 1 })
+
+		if fmt.Sprint(pass.array) != tt.pass || fmt.Sprint(fail.array) != tt.fail {
+			t.Errorf("Partition(%v) = %v, %v, want %s, %s", tt.values, pass.array, fail.array, tt.pass, tt.fail)
+		}
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	tests := []struct {
+		values []string
+		want   string
+	}{
+		{[]string{"b", "a", "b", "c", "b"}, "b:3 a:1 c:1"},
+		{[]string{"x"}, "x:1"},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		counts := CountBy(NewWithEntries(tt.values), func(value string, index int) string { return value })
+
+		if got := entries(counts); got != tt.want {
+			t.Errorf("CountBy(%v) = %s, want %s", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestIndexBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	users := NewWithEntries([]user{{2, "ada"}, {1, "bob"}, {2, "eve"}})
+	index := IndexBy(users, func(value user, i int) int { return value.ID })
+
+	// The last user with an ID wins, but the ID keeps the position of its first occurrence.
+	if got, want := entries(index), "2:{2 eve} 1:{1 bob}"; got != want {
+		t.Errorf("IndexBy() = %s, want %s", got, want)
+	}
+}
+
+func TestOrderedMap(t *testing.T) {
+	m := CountBy(NewWithEntries([]string{"c", "a", "c", "b"}), func(value string, index int) string { return value })
+
+	if m.Size() != 3 {
+		t.Errorf("Size() = %d, want 3", m.Size())
+	}
+
+	if v, ok := m.Get("c"); v != 2 || !ok {
+		t.Errorf("Get(c) = %d, %v, want 2, true", v, ok)
+	}
+
+	if v, ok := m.Get("z"); v != 0 || ok {
+		t.Errorf("Get(z) = %d, %v, want 0, false", v, ok)
+	}
+
+	if !m.Has("a") || m.Has("z") {
+		t.Error("Has() reported the wrong keys")
+	}
+
+	keys := []string{}
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+
+	values := []int{}
+	for v := range m.Values() {
+		values = append(values, v)
+	}
+
+	visited := []string{}
+	m.ForEach(func(value int, key string) { visited = append(visited, fmt.Sprint(key, value)) })
+
+	if got := fmt.Sprint(keys, values, visited); got != "[c a b] [2 1 1] [c2 a1 b1]" {
+		t.Errorf("Keys, Values and ForEach visited %s, want [c a b] [2 1 1] [c2 a1 b1]", got)
+	}
+
+	// The iterators stop when the loop breaks; the runtime panics if one calls yield again.
+	for range m.Keys() {
+		break
+	}
+
+	for range m.Entries() {
+		break
+	}
+
+	for range m.Values() {
+		break
+	}
+}
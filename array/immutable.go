@@ -0,0 +1,748 @@
+package array
+
+import (
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/iVitaliya/javascript-go/internal/bounds"
+)
+
+const (
+	immutableBits  = 5
+	immutableWidth = 1 << immutableBits
+	immutableMask  = immutableWidth - 1
+
+	// immutableExtra is how many nodes more than the fewest possible a level may be left with after a
+	// concatenation before its nodes are repacked, which keeps the trie shallow without repacking on every concat.
+	immutableExtra = 2
+)
+
+// immutableNode is a node of the trie backing an ImmutableArray. Branch nodes hold up to 32 children,
+// leaf nodes hold up to 32 values. Nodes are never modified once they are reachable from an array.
+//
+// A branch node whose children are all full except the last is dense, and is indexed by the bits of the index
+// alone. Any other branch node is relaxed and keeps the cumulative number of elements below each child in sizes,
+// which is nil for dense nodes.
+type immutableNode[T any] struct {
+	children []*immutableNode[T]
+	values   []T
+	sizes    []int
+	owner    *immutableOwner
+}
+
+// immutableOwner marks the nodes created by a single bulk operation, such as Push with many values.
+// Until the operation returns, no other array can reach those nodes, so it may change them in place
+// instead of copying them again for every block of 32 values.
+type immutableOwner struct {
+	_ int
+}
+
+// ImmutableArray is an array that can never be modified. Every "modifying" method returns a new
+// ImmutableArray instead, leaving the original untouched, in the style of the Records & Tuples proposal.
+//
+// It is a persistent vector: the elements are kept in a relaxed radix balanced (RRB) trie of 32-way nodes
+// plus a tail of up to 32 elements, and new versions share every node they did not change with the version
+// they were derived from. At, With, Pop and a Push of a single value run in O(log32 n), which is effectively
+// constant, and copy at most a handful of small nodes rather than the whole array. Concat and Slice also run
+// in O(log32 n) whatever the lengths of the arrays, by joining and cutting tries along their edges, and
+// ToSpliced costs the same plus the number of items inserted. Pushing many values at once fills whole
+// blocks of 32 before adding them to the trie.
+//
+// Example:
+//
+//	v1 := array.NewImmutable(1, 2, 3)
+//	v2 := v1.Push(4)
+//	v3, _ := v2.With(0, 9)
+//	// v1 is [1 2 3], v2 is [1 2 3 4] and v3 is [9 2 3 4]
+type ImmutableArray[T any] struct {
+	length int
+	shift  int
+	root   *immutableNode[T]
+	tail   []T
+	equal  Equality[T]
+}
+
+// NewImmutable returns a new immutable array holding the given entries.
+func NewImmutable[T any](entries ...T) *ImmutableArray[T] {
+	return emptyImmutable[T](nil).Push(entries...)
+}
+
+// ToImmutable returns an immutable array holding the current elements of the array.
+// Later changes to the array do not affect the returned immutable array.
+func (array *Array[T]) ToImmutable() *ImmutableArray[T] {
+	if array.tracing() {
+		defer array.traceCall("ToImmutable")()
+	}
+
+	return emptyImmutable(array.equal).Push(array.array...)
+}
+
+// emptyImmutable returns an empty immutable array comparing its elements with the given equality function.
+func emptyImmutable[T any](equal Equality[T]) *ImmutableArray[T] {
+	return &ImmutableArray[T]{
+		shift: immutableBits,
+		root:  &immutableNode[T]{},
+		equal: equal,
+	}
+}
+
+// At returns the value at the given index.
+// Negative indices count back from the end of the array, so At(-1) returns the last element.
+// If the index is out of range, it returns a zero value of type T.
+func (array *ImmutableArray[T]) At(index int) T {
+	k, ok := bounds.Relative(index, array.length)
+	if !ok {
+		return *new(T)
+	}
+
+	values, offset := array.leafFor(k)
+	return values[offset]
+}
+
+// Concat returns a new immutable array with the elements of the given arrays appended to the elements of this one.
+// The elements of every array are shared, not copied: the tries are joined along the seam between them, which
+// takes O(log32 n) time for each array however long it is.
+func (array *ImmutableArray[T]) Concat(others ...*ImmutableArray[T]) *ImmutableArray[T] {
+	result := array
+
+	for _, other := range others {
+		result = result.concat(other)
+	}
+
+	return result
+}
+
+// Entries returns an iterator over the index/value pairs of the array.
+func (array *ImmutableArray[T]) Entries() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+
+		each := func(values []T) bool {
+			for _, v := range values {
+				if !yield(i, v) {
+					return false
+				}
+
+				i++
+			}
+
+			return true
+		}
+
+		if eachImmutableLeaf(array.root, array.shift, each) {
+			each(array.tail)
+		}
+	}
+}
+
+// Includes determines whether the array includes a certain element, returning true or false as appropriate.
+func (array *ImmutableArray[T]) Includes(search_term T) bool {
+	return array.IndexOf(search_term) != -1
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in the array, or -1 if it is not present.
+// Elements are compared with the equality function of the array, which defaults to SameValueZero.
+func (array *ImmutableArray[T]) IndexOf(search_term T) int {
+	equal := array.equality()
+	for i, v := range array.Entries() {
+		if equal(v, search_term) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Join joins all elements of the array into a string, separated by the given separator.
+func (array *ImmutableArray[T]) Join(separator string) string {
+	var b strings.Builder
+
+	for i, v := range array.Entries() {
+		if i > 0 {
+			b.WriteString(separator)
+		}
+
+		b.WriteString(toJSString(v))
+	}
+
+	return b.String()
+}
+
+// Length returns the number of elements in the array.
+func (array *ImmutableArray[T]) Length() int {
+	return array.length
+}
+
+// Pop returns a new immutable array without the last element, along with that element.
+// If the array is empty, it returns the array itself and a zero value of type T.
+func (array *ImmutableArray[T]) Pop() (*ImmutableArray[T], T) {
+	if array.length == 0 {
+		return array, *new(T)
+	}
+
+	return array.take(array.length - 1), array.At(-1)
+}
+
+// Push returns a new immutable array with the given values appended to the end.
+// The values are gathered in blocks of 32 that are added to the trie whole, and the nodes the call creates
+// are filled in place until it returns, so pushing k values costs O(k) rather than k separate pushes.
+func (array *ImmutableArray[T]) Push(values ...T) *ImmutableArray[T] {
+	if len(values) == 0 {
+		return array
+	}
+
+	var (
+		result = *array
+		owner  = &immutableOwner{}
+		tail   = make([]T, len(array.tail), immutableWidth)
+	)
+
+	copy(tail, array.tail)
+
+	for _, v := range values {
+		if len(tail) == immutableWidth {
+			result.pushLeaf(&immutableNode[T]{values: tail}, owner)
+			tail = make([]T, 0, immutableWidth)
+		}
+
+		tail = append(tail, v)
+	}
+
+	result.tail = tail
+	result.length += len(values)
+
+	return &result
+}
+
+// SetEquality returns a new immutable array with the same elements, comparing them with the given equality function.
+// Passing nil restores the default SameValueZero comparison.
+func (array *ImmutableArray[T]) SetEquality(equal Equality[T]) *ImmutableArray[T] {
+	result := *array
+	result.equal = equal
+
+	return &result
+}
+
+// Slice returns a new immutable array with the elements from the start index to the end index (exclusive).
+// Negative indices are treated as offsets from the end of the array, and indices out of range are clamped.
+// If no end index is given, the slice extends to the end of the array.
+// The slice shares its elements with this array, and is cut out of its trie in O(log32 n) time.
+func (array *ImmutableArray[T]) Slice(start int, end ...int) *ImmutableArray[T] {
+	var (
+		from  = bounds.Clamp(start, array.length)
+		final = bounds.End(end, array.length)
+	)
+
+	if final <= from {
+		return emptyImmutable(array.equal)
+	}
+
+	return array.take(final).drop(from)
+}
+
+// ToArray returns a new Array holding the elements of the immutable array.
+func (array *ImmutableArray[T]) ToArray() *Array[T] {
+	result := make([]T, 0, array.length)
+	for v := range array.Values() {
+		result = append(result, v)
+	}
+
+	return &Array[T]{
+		array: result,
+		equal: array.equal,
+	}
+}
+
+// ToSpliced returns a new immutable array with elements removed, replaced or added, like Array.ToSpliced.
+// The elements before and after the removed ones are shared with this array: the result is the part before
+// the start index, the items, and the part after the removed elements concatenated, in O(log32 n) time plus
+// the number of items.
+func (array *ImmutableArray[T]) ToSpliced(start, deleteCount int, items ...T) *ImmutableArray[T] {
+	start, deleteCount = spliceBounds(start, deleteCount, array.length)
+
+	return array.take(start).Push(items...).concat(array.drop(start + deleteCount))
+}
+
+// ToString returns a string representation of the array, formatted like Array.ToString.
+func (array *ImmutableArray[T]) ToString() string {
+	return array.ToArray().ToString()
+}
+
+// Values returns an iterator over the elements of the array.
+func (array *ImmutableArray[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range array.Entries() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// With returns a new immutable array with the value at the given index replaced with the given value.
+// Negative indices count back from the end of the array. If the index is out of range, it returns a *RangeError.
+func (array *ImmutableArray[T]) With(index int, value T) (*ImmutableArray[T], error) {
+	k, err := checkIndex("With", index, array.length)
+	if err != nil {
+		return nil, err
+	}
+
+	result := *array
+
+	if offset := array.tailOffset(); k >= offset {
+		result.tail = cloneSlice(array.tail)
+		result.tail[k-offset] = value
+	} else {
+		result.root = assocImmutable(array.root, array.shift, k, value)
+	}
+
+	return &result, nil
+}
+
+// equality returns the equality function of the array, or SameValueZero resolved for its element type.
+func (array *ImmutableArray[T]) equality() Equality[T] {
+	if array.equal == nil {
+		return sameValueZero[T]()
+	}
+
+	return array.equal
+}
+
+// tailOffset returns the index of the first element kept in the tail rather than in the trie.
+func (array *ImmutableArray[T]) tailOffset() int {
+	return array.length - len(array.tail)
+}
+
+// leafFor returns the block of values holding the element at the given index, which must be in range,
+// along with the position of the element in the block.
+func (array *ImmutableArray[T]) leafFor(index int) ([]T, int) {
+	if offset := array.tailOffset(); index >= offset {
+		return array.tail, index - offset
+	}
+
+	node := array.root
+	for level := array.shift; level > 0; level -= immutableBits {
+		var child int
+		child, index = node.child(level, index)
+		node = node.children[child]
+	}
+
+	return node.values, index
+}
+
+// pushLeaf adds the leaf to the right edge of the trie, adding a level above the root when the trie is full.
+// Nodes marked with the given owner are changed in place; any other node on the way is copied.
+func (array *ImmutableArray[T]) pushLeaf(leaf *immutableNode[T], owner *immutableOwner) {
+	if root, ok := appendImmutableLeaf(array.root, array.shift, leaf, owner); ok {
+		array.root = root
+		return
+	}
+
+	array.root = newImmutableBranch([]*immutableNode[T]{array.root, newImmutablePath(array.shift, leaf, owner)}, array.shift+immutableBits, owner)
+	array.shift += immutableBits
+}
+
+// concat returns a new immutable array with the elements of the other array appended to the elements of this one.
+func (array *ImmutableArray[T]) concat(other *ImmutableArray[T]) *ImmutableArray[T] {
+	switch {
+	case other.length == 0:
+		return array
+	case array.length == 0:
+		result := *other
+		result.equal = array.equal
+
+		return &result
+	case other.length <= immutableWidth:
+		return array.Push(slices.Collect(other.Values())...)
+	}
+
+	// Move the tail of this array into its trie, so that the trie holds every element before those of other,
+	// and join the two tries. The tail of other stays the tail of the result.
+	left := *array
+	left.pushLeaf(&immutableNode[T]{values: array.tail}, nil)
+
+	result := &ImmutableArray[T]{
+		length: array.length + other.length,
+		tail:   other.tail,
+		equal:  array.equal,
+	}
+
+	merged := mergeImmutable(left.root, left.shift, other.root, other.shift)
+	result.root, result.shift = merged, max(left.shift, other.shift)+immutableBits
+	result.normalize()
+
+	return result
+}
+
+// take returns an immutable array holding the first n elements of this one, sharing every node it can.
+func (array *ImmutableArray[T]) take(n int) *ImmutableArray[T] {
+	if n >= array.length {
+		return array
+	}
+
+	if n <= 0 {
+		return emptyImmutable(array.equal)
+	}
+
+	result := *array
+	result.length = n
+
+	// The cut falls inside the tail: only the tail shrinks.
+	if offset := array.tailOffset(); n > offset {
+		result.tail = array.tail[:n-offset]
+		return &result
+	}
+
+	// The cut falls inside the trie: the block holding the new last element becomes the tail,
+	// and the trie is cut after the blocks before it.
+	values, last := array.leafFor(n - 1)
+	result.tail = values[:last+1]
+
+	if inTrie := n - 1 - last; inTrie == 0 {
+		result.shift = immutableBits
+		result.root = &immutableNode[T]{}
+	} else {
+		result.root = takeImmutable(array.root, array.shift, inTrie)
+	}
+
+	result.normalize()
+	return &result
+}
+
+// drop returns an immutable array without the first n elements of this one, sharing every node it can.
+func (array *ImmutableArray[T]) drop(n int) *ImmutableArray[T] {
+	if n <= 0 {
+		return array
+	}
+
+	if n >= array.length {
+		return emptyImmutable(array.equal)
+	}
+
+	result := *array
+	result.length -= n
+
+	// The cut falls inside the tail: the trie is dropped whole.
+	if offset := array.tailOffset(); n >= offset {
+		result.tail = array.tail[n-offset:]
+		result.shift = immutableBits
+		result.root = &immutableNode[T]{}
+
+		return &result
+	}
+
+	result.root = dropImmutable(array.root, array.shift, n)
+	result.normalize()
+
+	return &result
+}
+
+// normalize removes the levels above the root that have a single child, which cutting and joining tries
+// can leave behind, so that the trie is no deeper than it needs to be.
+func (array *ImmutableArray[T]) normalize() {
+	for array.shift > immutableBits && len(array.root.children) == 1 {
+		array.root = array.root.children[0]
+		array.shift -= immutableBits
+	}
+}
+
+// child returns the position of the child of a branch node at the given level holding the element at the
+// given index, relative to the node, along with the index of the element relative to that child.
+func (node *immutableNode[T]) child(level, index int) (int, int) {
+	child := (index >> level) & immutableMask
+
+	if node.sizes == nil {
+		return child, index & (1<<level - 1)
+	}
+
+	// Children of a relaxed node may hold fewer elements than they have room for,
+	// so the child is at or after the one the bits of the index point to.
+	child = min(child, len(node.sizes)-1)
+	for node.sizes[child] <= index {
+		child++
+	}
+
+	if child > 0 {
+		index -= node.sizes[child-1]
+	}
+
+	return child, index
+}
+
+// slots returns how many values a leaf holds, or how many children a branch node holds.
+func (node *immutableNode[T]) slots(level int) int {
+	if level == 0 {
+		return len(node.values)
+	}
+
+	return len(node.children)
+}
+
+// immutableSize returns the number of elements held by the node at the given level.
+func immutableSize[T any](node *immutableNode[T], level int) int {
+	switch {
+	case level == 0:
+		return len(node.values)
+	case len(node.children) == 0:
+		return 0
+	case node.sizes != nil:
+		return node.sizes[len(node.sizes)-1]
+	}
+
+	last := len(node.children) - 1
+	return last<<level + immutableSize(node.children[last], level-immutableBits)
+}
+
+// newImmutableBranch returns a branch node at the given level holding the given children, which becomes
+// relaxed unless every child but the last is full.
+func newImmutableBranch[T any](children []*immutableNode[T], level int, owner *immutableOwner) *immutableNode[T] {
+	var (
+		sizes = make([]int, len(children))
+		dense = true
+		total = 0
+	)
+
+	for i, child := range children {
+		size := immutableSize(child, level-immutableBits)
+		if i < len(children)-1 && size != 1<<level {
+			dense = false
+		}
+
+		total += size
+		sizes[i] = total
+	}
+
+	if dense {
+		sizes = nil
+	}
+
+	return &immutableNode[T]{
+		children: children,
+		sizes:    sizes,
+		owner:    owner,
+	}
+}
+
+// newImmutablePath wraps the leaf in single-child branch nodes up to the given level.
+func newImmutablePath[T any](level int, leaf *immutableNode[T], owner *immutableOwner) *immutableNode[T] {
+	if level == 0 {
+		return leaf
+	}
+
+	return &immutableNode[T]{
+		children: []*immutableNode[T]{newImmutablePath(level-immutableBits, leaf, owner)},
+		owner:    owner,
+	}
+}
+
+// appendImmutableLeaf returns the branch node at the given level with the leaf added as its rightmost descendant,
+// or false if the node has no room left for it.
+func appendImmutableLeaf[T any](node *immutableNode[T], level int, leaf *immutableNode[T], owner *immutableOwner) (*immutableNode[T], bool) {
+	if last := len(node.children) - 1; level > immutableBits && last >= 0 {
+		if child, ok := appendImmutableLeaf(node.children[last], level-immutableBits, leaf, owner); ok {
+			return setImmutableChild(node, level, last, child, owner), true
+		}
+	}
+
+	if len(node.children) == immutableWidth {
+		return nil, false
+	}
+
+	return setImmutableChild(node, level, len(node.children), newImmutablePath(level-immutableBits, leaf, owner), owner), true
+}
+
+// setImmutableChild returns the branch node at the given level with its last child replaced, or a child
+// appended when the position is its length. The node is changed in place if it is marked with the owner.
+func setImmutableChild[T any](node *immutableNode[T], level, position int, child *immutableNode[T], owner *immutableOwner) *immutableNode[T] {
+	result := node
+	if owner == nil || node.owner != owner {
+		result = &immutableNode[T]{
+			children: cloneSlice(node.children),
+			sizes:    cloneSlice(node.sizes),
+			owner:    owner,
+		}
+
+		if node.sizes == nil {
+			result.sizes = nil
+		}
+	}
+
+	// Appending after a child that is not full leaves a gap the bits of an index cannot skip,
+	// so the node has to become relaxed.
+	if result.sizes == nil && position > 0 && position == len(result.children) &&
+		immutableSize(result.children[position-1], level-immutableBits) != 1<<level {
+		return newImmutableBranch(append(result.children, child), level, owner)
+	}
+
+	if position == len(result.children) {
+		result.children = append(result.children, child)
+	} else {
+		result.children[position] = child
+	}
+
+	if result.sizes != nil {
+		result.sizes = result.sizes[:position]
+
+		before := 0
+		if position > 0 {
+			before = result.sizes[position-1]
+		}
+
+		result.sizes = append(result.sizes, before+immutableSize(child, level-immutableBits))
+	}
+
+	return result
+}
+
+// assocImmutable returns a copy of the path from the node at the given level down to the element at the index,
+// with that element replaced by the value.
+func assocImmutable[T any](node *immutableNode[T], level, index int, value T) *immutableNode[T] {
+	if level == 0 {
+		values := cloneSlice(node.values)
+		values[index] = value
+
+		return &immutableNode[T]{values: values}
+	}
+
+	child, rest := node.child(level, index)
+
+	children := cloneSlice(node.children)
+	children[child] = assocImmutable(node.children[child], level-immutableBits, rest, value)
+
+	return &immutableNode[T]{
+		children: children,
+		sizes:    node.sizes,
+	}
+}
+
+// takeImmutable returns a node at the given level holding only the first count elements of the node,
+// where count is positive and ends on the boundary of a leaf. Only the nodes along the new right edge are copied.
+func takeImmutable[T any](node *immutableNode[T], level, count int) *immutableNode[T] {
+	last, rest := node.child(level, count-1)
+	children := cloneSlice(node.children[:last+1])
+
+	if level > immutableBits {
+		children[last] = takeImmutable(node.children[last], level-immutableBits, rest+1)
+	}
+
+	return newImmutableBranch(children, level, nil)
+}
+
+// dropImmutable returns a node at the given level holding the elements of the node from the index n on,
+// where n is less than the number of elements in the node. Only the nodes along the new left edge are copied.
+func dropImmutable[T any](node *immutableNode[T], level, n int) *immutableNode[T] {
+	first, rest := node.child(level, n)
+	children := append([]*immutableNode[T]{nil}, node.children[first+1:]...)
+
+	if level > immutableBits {
+		children[0] = dropImmutable(node.children[first], level-immutableBits, rest)
+	} else {
+		children[0] = &immutableNode[T]{values: node.children[first].values[rest:]}
+	}
+
+	return newImmutableBranch(children, level, nil)
+}
+
+// mergeImmutable joins two non-empty tries whose roots are at the given levels, rebuilding only the nodes
+// along the seam between them. It returns a node one level above the higher of the two roots, holding the
+// one or two nodes that the joined trie needs at that level.
+func mergeImmutable[T any](left *immutableNode[T], leftLevel int, right *immutableNode[T], rightLevel int) *immutableNode[T] {
+	var (
+		last  = len(left.children) - 1
+		level = max(leftLevel, rightLevel)
+		seam  []*immutableNode[T]
+	)
+
+	switch {
+	case leftLevel > rightLevel:
+		seam = mergeImmutable(left.children[last], leftLevel-immutableBits, right, rightLevel).children
+		return rebalanceImmutable(left.children[:last], seam, nil, level)
+	case leftLevel < rightLevel:
+		seam = mergeImmutable(left, leftLevel, right.children[0], rightLevel-immutableBits).children
+		return rebalanceImmutable(nil, seam, right.children[1:], level)
+	case level > immutableBits:
+		seam = mergeImmutable(left.children[last], leftLevel-immutableBits, right.children[0], rightLevel-immutableBits).children
+		return rebalanceImmutable(left.children[:last], seam, right.children[1:], level)
+	}
+
+	return rebalanceImmutable(left.children, nil, right.children, level)
+}
+
+// rebalanceImmutable packs the given nodes, which are one level below the given level, into one or two nodes
+// at that level and returns them wrapped in a node one level above. If the nodes hold so few values or children
+// between them that more than immutableExtra of them could be saved, they are first repacked into full nodes,
+// so that the trie stays as shallow as one built by pushing values one by one, give or take a level.
+func rebalanceImmutable[T any](left, seam, right []*immutableNode[T], level int) *immutableNode[T] {
+	var (
+		nodes = make([]*immutableNode[T], 0, len(left)+len(seam)+len(right))
+		below = level - immutableBits
+		slots = 0
+	)
+
+	nodes = append(append(append(nodes, left...), seam...), right...)
+	for _, node := range nodes {
+		slots += node.slots(below)
+	}
+
+	if fewest := (slots + immutableWidth - 1) / immutableWidth; len(nodes) > fewest+immutableExtra {
+		nodes = repackImmutable(nodes, below, slots)
+	}
+
+	parents := make([]*immutableNode[T], 0, 2)
+	for i := 0; i < len(nodes); i += immutableWidth {
+		parents = append(parents, newImmutableBranch(cloneSlice(nodes[i:min(i+immutableWidth, len(nodes))]), level, nil))
+	}
+
+	return newImmutableBranch(parents, level+immutableBits, nil)
+}
+
+// repackImmutable returns nodes at the given level holding the same values or children as the given nodes,
+// which hold the given number of them between them, packed into as few full nodes as possible.
+func repackImmutable[T any](nodes []*immutableNode[T], level, slots int) []*immutableNode[T] {
+	result := make([]*immutableNode[T], 0, (slots+immutableWidth-1)/immutableWidth)
+
+	if level == 0 {
+		values := make([]T, 0, slots)
+		for _, node := range nodes {
+			values = append(values, node.values...)
+		}
+
+		for i := 0; i < len(values); i += immutableWidth {
+			result = append(result, &immutableNode[T]{values: values[i:min(i+immutableWidth, len(values)):min(i+immutableWidth, len(values))]})
+		}
+
+		return result
+	}
+
+	children := make([]*immutableNode[T], 0, slots)
+	for _, node := range nodes {
+		children = append(children, node.children...)
+	}
+
+	for i := 0; i < len(children); i += immutableWidth {
+		result = append(result, newImmutableBranch(cloneSlice(children[i:min(i+immutableWidth, len(children))]), level, nil))
+	}
+
+	return result
+}
+
+// eachImmutableLeaf calls fn with the values of every leaf below the branch node at the given level, in order,
+// and reports whether fn returned true for all of them.
+func eachImmutableLeaf[T any](node *immutableNode[T], level int, fn func(values []T) bool) bool {
+	for _, child := range node.children {
+		if level == immutableBits {
+			if !fn(child.values) {
+				return false
+			}
+
+			continue
+		}
+
+		if !eachImmutableLeaf(child, level-immutableBits, fn) {
+			return false
+		}
+	}
+
+	return true
+}
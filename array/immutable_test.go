@@ -0,0 +1,262 @@
+package array
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// checkImmutable fails the test if the trie of the array does not hold exactly the given values,
+// or if any of its nodes breaks the invariants lookups rely on.
+func checkImmutable(t *testing.T, op string, arr *ImmutableArray[int], want []int) {
+	t.Helper()
+
+	if arr.Length() != len(want) {
+		t.Fatalf("%s: Length() = %d, want %d", op, arr.Length(), len(want))
+	}
+
+	if arr.length > 0 && len(arr.tail) == 0 {
+		t.Fatalf("%s: non-empty array has an empty tail", op)
+	}
+
+	var walk func(node *immutableNode[int], level int) int
+	walk = func(node *immutableNode[int], level int) int {
+		if level == 0 {
+			if len(node.values) == 0 || len(node.values) > immutableWidth {
+				t.Fatalf("%s: leaf holds %d values", op, len(node.values))
+			}
+
+			return len(node.values)
+		}
+
+		if len(node.children) > immutableWidth {
+			t.Fatalf("%s: node at level %d has %d children", op, level, len(node.children))
+		}
+
+		total := 0
+		for i, child := range node.children {
+			size := walk(child, level-immutableBits)
+			total += size
+
+			if node.sizes == nil && i < len(node.children)-1 && size != 1<<level {
+				t.Fatalf("%s: dense node at level %d has a child of size %d at %d", op, level, size, i)
+			}
+
+			if node.sizes != nil && node.sizes[i] != total {
+				t.Fatalf("%s: node at level %d records size %d at %d, want %d", op, level, node.sizes[i], i, total)
+			}
+		}
+
+		return total
+	}
+
+	if got := walk(arr.root, arr.shift); got != arr.tailOffset() {
+		t.Fatalf("%s: trie holds %d values, want %d", op, got, arr.tailOffset())
+	}
+
+	// Repacking keeps the trie within a level of the one pushing the values would have built.
+	levels := 1
+	for capacity := immutableWidth * immutableWidth; capacity < len(want); capacity *= immutableWidth {
+		levels++
+	}
+
+	if arr.shift > (levels+1)*immutableBits {
+		t.Fatalf("%s: trie of %d values is %d levels deep", op, len(want), arr.shift/immutableBits)
+	}
+
+	if got := slices.Collect(arr.Values()); !slices.Equal(got, want) {
+		t.Fatalf("%s: Values() = %v, want %v", op, got, want)
+	}
+
+	for i, v := range want {
+		if got := arr.At(i); got != v {
+			t.Fatalf("%s: At(%d) = %d, want %d", op, i, got, v)
+		}
+	}
+}
+
+// sequence returns the integers from start up to, but not including, end.
+func sequence(start, end int) []int {
+	result := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		result = append(result, i)
+	}
+
+	return result
+}
+
+func TestImmutablePushAndPop(t *testing.T) {
+	for _, n := range []int{0, 1, 31, 32, 33, 1024, 1056, 1057, 40000} {
+		want := sequence(0, n)
+		arr := NewImmutable(want...)
+		checkImmutable(t, "NewImmutable", arr, want)
+
+		one := emptyImmutable[int](nil)
+		for _, v := range want {
+			one = one.Push(v)
+		}
+
+		checkImmutable(t, "Push", one, want)
+
+		for len(want) > n-100 && len(want) > 0 {
+			var last int
+			arr, last = arr.Pop()
+			want = want[:len(want)-1]
+
+			if last != len(want) {
+				t.Fatalf("Pop() returned %d, want %d", last, len(want))
+			}
+
+			checkImmutable(t, "Pop", arr, want)
+		}
+	}
+}
+
+func TestImmutableSliceAndConcat(t *testing.T) {
+	want := sequence(0, 5000)
+	arr := NewImmutable(want...)
+
+	for _, cut := range [][2]int{{0, 5000}, {1, 5000}, {31, 33}, {32, 64}, {100, 4000}, {1024, 1025}, {4999, 5000}, {-40, -1}} {
+		from, to := cut[0], cut[1]
+		if from < 0 {
+			from, to = len(want)+from, len(want)+to
+		}
+
+		sliced := arr.Slice(cut[0], cut[1])
+		checkImmutable(t, "Slice", sliced, want[from:to])
+
+		joined := arr.Slice(0, cut[0]).Concat(sliced, arr.Slice(cut[1]))
+		checkImmutable(t, "Concat", joined, want)
+	}
+}
+
+func TestImmutableManySmallConcats(t *testing.T) {
+	var (
+		arr  = NewImmutable[int]()
+		want []int
+	)
+
+	// Joining many short, partly filled arrays leaves relaxed nodes everywhere along the seams.
+	for i := 0; i < 2000; i++ {
+		part := sequence(len(want), len(want)+i%70+1)
+		other := NewImmutable(sequence(0, 40)...).Concat(NewImmutable(part...)).Slice(40)
+
+		arr = arr.Concat(other)
+		want = append(want, part...)
+	}
+
+	checkImmutable(t, "Concat", arr, want)
+
+	for i := 0; i < len(want); i += 997 {
+		arr, _ = arr.With(i, -i)
+		want[i] = -i
+	}
+
+	checkImmutable(t, "With", arr, want)
+	checkImmutable(t, "Push", arr.Push(1, 2, 3), append(slices.Clone(want), 1, 2, 3))
+}
+
+func TestImmutableRandomOperations(t *testing.T) {
+	var (
+		random = rand.New(rand.NewSource(1))
+		arr    = NewImmutable[int]()
+		want   []int
+		next   int
+	)
+
+	values := func(n int) []int {
+		next += n
+		return sequence(next-n, next)
+	}
+
+	for step := 0; step < 2000; step++ {
+		length := len(want)
+
+		switch op := random.Intn(7); {
+		case op == 0 || length == 0:
+			items := values(random.Intn(100))
+			arr, want = arr.Push(items...), append(want, items...)
+			checkImmutable(t, "Push", arr, want)
+		case op == 1:
+			arr, _ = arr.Pop()
+			want = want[:length-1]
+			checkImmutable(t, "Pop", arr, want)
+		case op == 2:
+			index, value := random.Intn(length), values(1)[0]
+			arr, _ = arr.With(index, value)
+			want = slices.Clone(want)
+			want[index] = value
+			checkImmutable(t, "With", arr, want)
+		case op == 3:
+			items := values(random.Intn(3000))
+			other := NewImmutable(items...).Slice(random.Intn(len(items) + 1))
+			arr, want = arr.Concat(other), append(want, items[len(items)-other.Length():]...)
+			checkImmutable(t, "Concat", arr, want)
+		case op == 4:
+			arr, want = arr.Concat(arr), append(want, want...)
+			checkImmutable(t, "Concat with itself", arr, want)
+		case op == 5:
+			from := random.Intn(length)
+			to := from + random.Intn(length-from+1)
+			arr, want = arr.Slice(from, to), slices.Clone(want[from:to])
+			checkImmutable(t, "Slice", arr, want)
+		default:
+			start, count, items := random.Intn(length+1), random.Intn(100), values(random.Intn(5))
+			arr = arr.ToSpliced(start, count, items...)
+			want = slices.Concat(want[:start], items, want[min(start+count, length):])
+			checkImmutable(t, "ToSpliced", arr, want)
+		}
+
+		if len(want) > 50000 {
+			arr, want = arr.Slice(0, 1000), slices.Clone(want[:1000])
+		}
+	}
+}
+
+func TestImmutableVersionsAreIndependent(t *testing.T) {
+	v1 := NewImmutable(sequence(0, 100)...)
+	v2 := v1.Push(100)
+	v3, _ := v2.With(0, -1)
+	v4 := v1.Slice(10, 90).Concat(v3)
+	v5, _ := v1.Pop()
+
+	checkImmutable(t, "v1", v1, sequence(0, 100))
+	checkImmutable(t, "v2", v2, sequence(0, 101))
+	checkImmutable(t, "v3", v3, append([]int{-1}, sequence(1, 101)...))
+	checkImmutable(t, "v4", v4, slices.Concat(sequence(10, 90), []int{-1}, sequence(1, 101)))
+	checkImmutable(t, "v5", v5, sequence(0, 99))
+}
+
+const benchmarkImmutableLength = 100000
+
+func BenchmarkImmutableConcat(b *testing.B) {
+	arr := NewImmutable(make([]int, benchmarkImmutableLength)...)
+
+	for i := 0; i < b.N; i++ {
+		arr.Concat(arr)
+	}
+}
+
+func BenchmarkImmutableSlice(b *testing.B) {
+	arr := NewImmutable(make([]int, benchmarkImmutableLength)...)
+
+	for i := 0; i < b.N; i++ {
+		arr.Slice(1000, benchmarkImmutableLength-1000)
+	}
+}
+
+func BenchmarkImmutableToSpliced(b *testing.B) {
+	arr := NewImmutable(make([]int, benchmarkImmutableLength)...)
+
+	for i := 0; i < b.N; i++ {
+		arr.ToSpliced(benchmarkImmutableLength/2, 1, i)
+	}
+}
+
+func BenchmarkImmutablePush(b *testing.B) {
+	values := make([]int, benchmarkImmutableLength)
+
+	for i := 0; i < b.N; i++ {
+		NewImmutable(values...)
+	}
+}
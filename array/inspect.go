@@ -0,0 +1,866 @@
+package array
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"iter"
+	"math"
+	"reflect"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/iVitaliya/colors-go"
+)
+
+// Theme holds the styles Inspect applies to each kind of value when colours are turned on.
+// A nil style leaves that kind of value unstyled.
+type Theme struct {
+	// Number styles integers, floating-point and complex numbers.
+	Number func(string) string
+	// Boolean styles true and false.
+	Boolean func(string) string
+	// String styles quoted strings.
+	String func(string) string
+	// Nil styles nil pointers, interfaces, functions and channels.
+	Nil func(string) string
+	// Empty styles the holes of a sparse array.
+	Empty func(string) string
+	// Special styles functions, channels, circular references and values beyond the depth limit.
+	Special func(string) string
+	// Date styles time.Time values.
+	Date func(string) string
+}
+
+// DefaultTheme colours values like Node.js' util.inspect does.
+var DefaultTheme = Theme{
+	Number:  colors.Yellow,
+	Boolean: colors.Yellow,
+	String:  colors.Green,
+	Nil:     colors.Bold,
+	Empty:   colors.Gray,
+	Special: colors.Cyan,
+	Date:    colors.Magenta,
+}
+
+// BrightTheme is DefaultTheme with the bright variant of every colour, for dark terminals.
+var BrightTheme = Theme{
+	Number:  colors.BrightYellow,
+	Boolean: colors.BrightYellow,
+	String:  colors.BrightGreen,
+	Nil:     colors.Bold,
+	Empty:   colors.BrightBlack,
+	Special: colors.BrightCyan,
+	Date:    colors.BrightMagenta,
+}
+
+// InspectOptions controls how Inspect formats a value. Start from DefaultInspectOptions and change the
+// fields you need, as the zero value shows nothing below the top level and breaks every line.
+type InspectOptions struct {
+	// Depth is the number of levels of nested arrays, maps and structs to show. Deeper values are shown as
+	// [Array], [Map], [Object] or the name of their type. Use Infinity to show every level.
+	Depth int
+	// MaxArrayLength is the maximum number of elements of an array or map to show, with the rest summed up
+	// as "... 90 more items". Use Infinity to show every element.
+	MaxArrayLength int
+	// BreakLength is the width at which the elements of an array, map or struct are split onto one line each.
+	// Use Infinity to keep everything on a single line.
+	BreakLength int
+	// Colors turns on the styles of the theme. If colors-go cannot colour the terminal, the output is left
+	// unstyled rather than panicking.
+	Colors bool
+	// Theme is the set of styles to use when Colors is true.
+	Theme Theme
+}
+
+// DefaultInspectOptions returns the options Inspect uses when none are given, which are the defaults of
+// Node.js' util.inspect: a depth of 2, at most 100 elements per array, a break length of 80 and no colours.
+func DefaultInspectOptions() InspectOptions {
+	return InspectOptions{
+		Depth:          2,
+		MaxArrayLength: 100,
+		BreakLength:    80,
+		Theme:          DefaultTheme,
+	}
+}
+
+// Inspect returns a human-readable representation of any value, like Node.js' util.inspect.
+// Arrays of this package, slices and Go arrays are shown as [ 1, 2, 3 ], with the holes of a SparseArray
+// shown as <2 empty items>; other array-like values such as a Deque or a typed array are prefixed with
+// their type and length, as in Deque(3) [ 1, 2, 3 ]. Maps are shown as Map(2) { 'a' => 1, 'b' => 2 }
+// with their keys sorted, structs as Pair { First: 1, Second: 2 } with their exported fields, strings are
+// quoted and nil is shown as nil. Values implementing error or fmt.Stringer are shown as their text.
+//
+// Short values are kept on a single line, and longer ones are split with one element per line, or in
+// aligned columns for long arrays of short elements. A value that contains itself is shown as
+// [Circular *1], and the value it refers back to is marked with <ref *1>.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]any{1, "two", []int{3}, map[string]int{"four": 4}})
+//	array.Inspect(arr) // [ 1, 'two', [ 3 ], Map(1) { 'four' => 4 } ]
+//
+//	opts := array.DefaultInspectOptions()
+//	opts.Depth = array.Infinity
+//	opts.Colors = true
+//	fmt.Println(array.Inspect(arr, opts))
+func Inspect(value any, options ...InspectOptions) string {
+	ins := &inspector{
+		options:  DefaultInspectOptions(),
+		circular: map[inspectRef]int{},
+	}
+
+	if len(options) > 0 {
+		ins.options = options[0]
+	}
+
+	return ins.format(reflect.ValueOf(value), 0)
+}
+
+// Inspect returns a human-readable representation of the array. See the package-level Inspect.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]string{"a", "b"})
+//	arr.Inspect() // [ 'a', 'b' ]
+func (array *Array[T]) Inspect(options ...InspectOptions) string {
+	return Inspect(array, options...)
+}
+
+// Format implements fmt.Formatter. The %v and %s verbs print the array like Inspect with the default
+// options, %+v prints every element at every depth, and %#v prints the Go syntax that creates the array.
+// Any other verb is applied to the elements, so %x prints each of them in hexadecimal.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{1, 2, 3})
+//	fmt.Printf("%v\n", arr)  // [ 1, 2, 3 ]
+//	fmt.Printf("%#v\n", arr) // array.NewWithEntries([]int{1, 2, 3})
+//	fmt.Printf("%x\n", arr)  // [1 2 3]
+func (array *Array[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "array.NewWithEntries(%#v)", array.array)
+	case verb == 'v' && f.Flag('+'):
+		options := DefaultInspectOptions()
+		options.Depth = Infinity
+		options.MaxArrayLength = Infinity
+
+		io.WriteString(f, Inspect(array, options))
+	case verb == 'v' || verb == 's':
+		io.WriteString(f, Inspect(array))
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), array.array)
+	}
+}
+
+// inspect shows the array as a plain JavaScript array.
+func (array *Array[T]) inspect(ins *inspector, level int) string {
+	return ins.array(reflect.ValueOf(array), level, "", len(array.array), func(yield func(int, reflect.Value) bool) {
+		for i := range array.array {
+			if !yield(i, reflect.ValueOf(&array.array[i]).Elem()) {
+				return
+			}
+		}
+	})
+}
+
+// inspect shows the sparse array as a plain JavaScript array with its holes.
+func (array *SparseArray[T]) inspect(ins *inspector, level int) string {
+	return ins.array(reflect.ValueOf(array), level, "", array.length, func(yield func(int, reflect.Value) bool) {
+		for i, v := range array.Elements() {
+			if !yield(i, reflect.ValueOf(&v).Elem()) {
+				return
+			}
+		}
+	})
+}
+
+// inspect shows a list as an array of its items, and any other nested value as the value itself.
+func (nested Nested[T]) inspect(ins *inspector, level int) string {
+	if !nested.isList {
+		return ins.format(reflect.ValueOf(&nested.value).Elem(), level)
+	}
+
+	return ins.array(reflect.Value{}, level, "", len(nested.items), func(yield func(int, reflect.Value) bool) {
+		for i := range nested.items {
+			if !yield(i, reflect.ValueOf(nested.items[i])) {
+				return
+			}
+		}
+	})
+}
+
+// inspectable is implemented by the types of this package that Inspect cannot format from the outside,
+// such as arrays whose elements are unexported.
+type inspectable interface {
+	inspect(ins *inspector, level int) string
+}
+
+// inspectRef identifies a pointer, map or slice that is being formatted, to detect cycles.
+type inspectRef struct {
+	pointer uintptr
+	length  int
+	typ     reflect.Type
+}
+
+// inspectList is the formatted contents of an array, map or struct, before they are laid out.
+type inspectList struct {
+	open, close string
+	entries     []string
+	// array allows the entries to be grouped into columns, numeric aligns them to the right when they are,
+	// and truncated reports that the last entry is the "... more items" summary.
+	array, numeric, truncated bool
+}
+
+// inspector holds the state of a call to Inspect, following the algorithm of Node.js' util.inspect.
+type inspector struct {
+	options InspectOptions
+	// indent is the indentation of the value being formatted, and current the level of the last
+	// array, map or struct whose contents were formatted.
+	indent, current int
+	seen            []inspectRef
+	circular        map[inspectRef]int
+}
+
+// format formats any value at the given level of nesting.
+func (ins *inspector) format(v reflect.Value, level int) string {
+	if !v.IsValid() {
+		return ins.style(ins.options.Theme.Nil, "nil")
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		return ins.format(v.Elem(), level)
+	case reflect.Pointer, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			return ins.style(ins.options.Theme.Nil, "nil")
+		}
+	}
+
+	if v.CanInterface() {
+		if byPointer(v) {
+			copied := reflect.New(v.Type())
+			copied.Elem().Set(v)
+			v = copied
+		}
+
+		switch value := v.Interface().(type) {
+		case inspectable:
+			return value.inspect(ins, level)
+		case time.Time:
+			return ins.style(ins.options.Theme.Date, value.Format("2006-01-02T15:04:05.000Z07:00"))
+		case error:
+			return value.Error()
+		case fmt.Stringer:
+			return value.String()
+		}
+
+		if length, at, ok := arrayLikeMethods(v); ok {
+			return ins.array(v, level, typeName(v.Type()), length, func(yield func(int, reflect.Value) bool) {
+				for i := 0; i < length; i++ {
+					if !yield(i, at.Call([]reflect.Value{reflect.ValueOf(i)})[0]) {
+						return
+					}
+				}
+			})
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return ins.style(ins.options.Theme.Boolean, strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return ins.style(ins.options.Theme.Number, strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return ins.style(ins.options.Theme.Number, strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return ins.style(ins.options.Theme.Number, inspectNumber(v))
+	case reflect.Complex64, reflect.Complex128:
+		return ins.style(ins.options.Theme.Number, fmt.Sprint(v.Complex()))
+	case reflect.String:
+		return ins.style(ins.options.Theme.String, quote(v.String()))
+	case reflect.Func:
+		name := " (anonymous)"
+		if fn := runtime.FuncForPC(v.Pointer()); fn != nil {
+			name = ": " + fn.Name()
+		}
+
+		return ins.style(ins.options.Theme.Special, "[Function"+name+"]")
+	case reflect.Chan:
+		return ins.style(ins.options.Theme.Special, "["+v.Type().String()+"]")
+	case reflect.UnsafePointer:
+		return ins.style(ins.options.Theme.Special, fmt.Sprintf("[unsafe.Pointer %#x]", v.Pointer()))
+	case reflect.Slice, reflect.Array:
+		return ins.slice(v, v, level)
+	case reflect.Map:
+		return ins.mapping(v, level)
+	case reflect.Struct:
+		return ins.structure(v, v, level)
+	case reflect.Pointer:
+		switch v.Elem().Kind() {
+		case reflect.Struct:
+			return ins.structure(v, v.Elem(), level)
+		case reflect.Array:
+			return ins.slice(v, v.Elem(), level)
+		}
+
+		ref, _ := refOf(v)
+		if n, ok := ins.cycle(ref); ok {
+			return ins.style(ins.options.Theme.Special, fmt.Sprintf("[Circular *%d]", n))
+		}
+
+		ins.seen = append(ins.seen, ref)
+		defer func() { ins.seen = ins.seen[:len(ins.seen)-1] }()
+
+		return ins.format(v.Elem(), level)
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+// slice formats a slice or Go array, prefixed with its type if the type has a name of its own.
+func (ins *inspector) slice(ref, v reflect.Value, level int) string {
+	return ins.array(ref, level, typeName(v.Type()), v.Len(), func(yield func(int, reflect.Value) bool) {
+		for i := 0; i < v.Len(); i++ {
+			if !yield(i, v.Index(i)) {
+				return
+			}
+		}
+	})
+}
+
+// array formats an array of the given length whose elements are yielded in order, with the indices of
+// the holes left out. A name, if any, is shown along with the length before the elements.
+func (ins *inspector) array(ref reflect.Value, level int, name string, length int, elements iter.Seq2[int, reflect.Value]) string {
+	var (
+		open        = "["
+		placeholder = "Array"
+	)
+
+	if name != "" {
+		open = fmt.Sprintf("%s(%d) [", name, length)
+		placeholder = name
+	}
+
+	return ins.container(ref, level, placeholder, func(level int) inspectList {
+		var (
+			list = inspectList{
+				open:    open,
+				close:   "]",
+				array:   true,
+				numeric: true,
+			}
+			limit = max(ins.options.MaxArrayLength, 0)
+			index = 0
+		)
+
+		for i, v := range elements {
+			if len(list.entries) >= limit {
+				break
+			}
+
+			if i != index {
+				list.entries = append(list.entries, ins.style(ins.options.Theme.Empty, emptyItems(i-index)))
+				list.numeric = false
+				index = i
+
+				if len(list.entries) == limit {
+					break
+				}
+			}
+
+			list.entries = append(list.entries, ins.format(v, level))
+			list.numeric = list.numeric && isNumber(v)
+			index++
+		}
+
+		remaining := length - index
+		switch {
+		case remaining <= 0:
+		case len(list.entries) < limit:
+			list.entries = append(list.entries, ins.style(ins.options.Theme.Empty, emptyItems(remaining)))
+		default:
+			list.entries = append(list.entries, moreItems(remaining))
+			list.truncated = true
+		}
+
+		return list
+	})
+}
+
+// mapping formats a map as a Map of its entries, ordered by key.
+func (ins *inspector) mapping(v reflect.Value, level int) string {
+	name := typeName(v.Type())
+	if name == "" {
+		name = "Map"
+	}
+
+	return ins.container(v, level, name, func(level int) inspectList {
+		type entry struct {
+			key   reflect.Value
+			label string
+		}
+
+		keys := make([]entry, 0, v.Len())
+		for _, k := range v.MapKeys() {
+			keys = append(keys, entry{
+				key:   k,
+				label: ins.format(k, level),
+			})
+		}
+
+		slices.SortFunc(keys, func(a, b entry) int {
+			return cmp.Or(compareKeys(a.key, b.key), cmp.Compare(a.label, b.label))
+		})
+
+		list := inspectList{
+			open:  fmt.Sprintf("%s(%d) {", name, v.Len()),
+			close: "}",
+		}
+
+		limit := min(max(ins.options.MaxArrayLength, 0), len(keys))
+		for _, k := range keys[:limit] {
+			list.entries = append(list.entries, k.label+" => "+ins.format(v.MapIndex(k.key), level))
+		}
+
+		if remaining := len(keys) - limit; remaining > 0 {
+			list.entries = append(list.entries, moreItems(remaining))
+		}
+
+		return list
+	})
+}
+
+// structure formats the exported fields of a struct, prefixed with the name of its type.
+func (ins *inspector) structure(ref, v reflect.Value, level int) string {
+	var (
+		name        = typeName(v.Type())
+		open        = "{"
+		placeholder = "Object"
+	)
+
+	if name != "" {
+		open = name + " {"
+		placeholder = name
+	}
+
+	return ins.container(ref, level, placeholder, func(level int) inspectList {
+		list := inspectList{
+			open:  open,
+			close: "}",
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Type().Field(i); field.IsExported() {
+				list.entries = append(list.entries, field.Name+": "+ins.format(v.Field(i), level))
+			}
+		}
+
+		return list
+	})
+}
+
+// container formats a value holding other values. Past the depth limit it is replaced by its placeholder,
+// and if ref, the pointer, map or slice it is reached through, is already being formatted, by a circular
+// reference to it.
+func (ins *inspector) container(ref reflect.Value, level int, placeholder string, build func(level int) inspectList) string {
+	key, tracked := refOf(ref)
+	if n, ok := ins.cycle(key); tracked && ok {
+		return ins.style(ins.options.Theme.Special, fmt.Sprintf("[Circular *%d]", n))
+	}
+
+	if level > ins.options.Depth {
+		return ins.style(ins.options.Theme.Special, "["+placeholder+"]")
+	}
+
+	if tracked {
+		ins.seen = append(ins.seen, key)
+		defer func() { ins.seen = ins.seen[:len(ins.seen)-1] }()
+	}
+
+	ins.current = level + 1
+	ins.indent += 2
+	list := build(level + 1)
+	ins.indent -= 2
+
+	base := ""
+	if n, ok := ins.circular[key]; tracked && ok {
+		base = ins.style(ins.options.Theme.Special, fmt.Sprintf("<ref *%d>", n))
+	}
+
+	return ins.reduce(list, base, level+1)
+}
+
+// cycle reports whether the reference is already being formatted, and returns the number of the
+// circular reference to it, assigning the next one the first time.
+func (ins *inspector) cycle(ref inspectRef) (int, bool) {
+	if !slices.Contains(ins.seen, ref) {
+		return 0, false
+	}
+
+	n, ok := ins.circular[ref]
+	if !ok {
+		n = len(ins.circular) + 1
+		ins.circular[ref] = n
+	}
+
+	return n, true
+}
+
+// reduce lays out a formatted list on a single line if it is short enough, and otherwise with one entry,
+// or one row of grouped entries, per line.
+func (ins *inspector) reduce(list inspectList, base string, level int) string {
+	start := visibleLength(base)
+	if base != "" {
+		base += " "
+	}
+
+	if len(list.entries) == 0 {
+		return base + list.open + list.close
+	}
+
+	entries := list.entries
+	if list.array && len(entries) > 6 {
+		entries = ins.group(list)
+	}
+
+	if ins.current-level < 3 && len(entries) == len(list.entries) {
+		start += len(entries) + ins.indent + visibleLength(list.open) + 10
+		if ins.fits(entries, start) {
+			if joined := strings.Join(entries, ", "); !strings.Contains(joined, "\n") {
+				return base + list.open + " " + joined + " " + list.close
+			}
+		}
+	}
+
+	indentation := "\n" + strings.Repeat(" ", ins.indent)
+	return base + list.open + indentation + "  " + strings.Join(entries, ","+indentation+"  ") + indentation + list.close
+}
+
+// fits reports whether the entries fit within the break length when put on the same line.
+func (ins *inspector) fits(entries []string, start int) bool {
+	total := len(entries) + start
+	if total+len(entries) > ins.options.BreakLength {
+		return false
+	}
+
+	for _, entry := range entries {
+		total += visibleLength(entry)
+		if total > ins.options.BreakLength {
+			return false
+		}
+	}
+
+	return true
+}
+
+// group arranges the entries of a long array of short elements into aligned columns, each returned entry
+// being a row. It returns the entries unchanged if they are too long or too uneven to be grouped.
+func (ins *inspector) group(list inspectList) []string {
+	const separatorSpace = 2 // a comma and a space between two entries
+
+	var (
+		output       = list.entries
+		outputLength = len(output)
+		totalLength  = 0
+		maxLength    = 0
+	)
+
+	// The "... more items" summary is not grouped with the elements.
+	if list.truncated {
+		outputLength--
+	}
+
+	dataLen := make([]int, outputLength)
+	for i := range dataLen {
+		dataLen[i] = visibleLength(output[i])
+		totalLength += dataLen[i] + separatorSpace
+		maxLength = max(maxLength, dataLen[i])
+	}
+
+	// Only group if at least three entries fit next to each other, and no entry is much longer than the
+	// others, which would leave too much space between the short ones.
+	actualMax := maxLength + separatorSpace
+	if actualMax*3+ins.indent >= ins.options.BreakLength || (float64(totalLength)/float64(actualMax) <= 5 && maxLength > 6) {
+		return output
+	}
+
+	// Aim for a square of entries, given that a character is about 2.5 times as high as it is wide,
+	// with at most 12 columns like the default compact mode of util.inspect.
+	var (
+		averageBias = math.Sqrt(float64(actualMax) - float64(totalLength)/float64(len(output)))
+		biasedMax   = math.Max(float64(actualMax)-3-averageBias, 1)
+		columns     = min(
+			int(math.Floor(math.Sqrt(2.5*biasedMax*float64(outputLength))/biasedMax+0.5)),
+			(ins.options.BreakLength-ins.indent)/actualMax,
+			12,
+		)
+	)
+
+	if columns <= 1 {
+		return output
+	}
+
+	maxLineLength := make([]int, columns)
+	for i := range maxLineLength {
+		for j := i; j < outputLength; j += columns {
+			maxLineLength[i] = max(maxLineLength[i], dataLen[j])
+		}
+
+		maxLineLength[i] += separatorSpace
+	}
+
+	grouped := []string{}
+	for i := 0; i < outputLength; i += columns {
+		var (
+			last = min(i+columns, outputLength) - 1
+			row  strings.Builder
+		)
+
+		for j := i; j < last; j++ {
+			pad(&row, output[j]+", ", maxLineLength[j-i]-dataLen[j]-separatorSpace, list.numeric)
+		}
+
+		if list.numeric {
+			pad(&row, output[last], maxLineLength[last-i]-dataLen[last]-separatorSpace, true)
+		} else {
+			row.WriteString(output[last])
+		}
+
+		grouped = append(grouped, row.String())
+	}
+
+	if list.truncated {
+		grouped = append(grouped, output[outputLength])
+	}
+
+	return grouped
+}
+
+// style applies a style of the theme to the text when colours are turned on.
+func (ins *inspector) style(style func(string) string, text string) string {
+	if !ins.options.Colors {
+		return text
+	}
+
+	return paint(style, text)
+}
+
+// paint applies a colors-go style to the text. colors-go panics when the terminal does not support
+// colours, in which case the text is returned unstyled.
+func paint(style func(string) string, text string) (painted string) {
+	if style == nil {
+		return text
+	}
+
+	defer func() {
+		if recover() != nil {
+			painted = text
+		}
+	}()
+
+	return style(text)
+}
+
+// pad writes the text to the row, padded with spaces on the left if right is true, and on the right otherwise.
+func pad(row *strings.Builder, text string, padding int, right bool) {
+	if right {
+		row.WriteString(strings.Repeat(" ", max(padding, 0)))
+		row.WriteString(text)
+		return
+	}
+
+	row.WriteString(text)
+	row.WriteString(strings.Repeat(" ", max(padding, 0)))
+}
+
+// refOf returns the reference identifying a non-empty pointer, map or slice, and whether the value is one.
+func refOf(v reflect.Value) (inspectRef, bool) {
+	if !v.IsValid() {
+		return inspectRef{}, false
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map:
+		if !v.IsNil() {
+			return inspectRef{pointer: v.Pointer(), typ: v.Type()}, true
+		}
+	case reflect.Slice:
+		if v.Len() > 0 {
+			return inspectRef{pointer: v.Pointer(), length: v.Len(), typ: v.Type()}, true
+		}
+	}
+
+	return inspectRef{}, false
+}
+
+// byPointer reports whether a struct value has to be formatted through a pointer to a copy of it, because
+// the methods Inspect looks for have pointer receivers, as with an Array, SparseArray or Deque passed by value.
+func byPointer(v reflect.Value) bool {
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+
+	pointer := reflect.PointerTo(v.Type())
+	if pointer.Implements(reflect.TypeFor[inspectable]()) {
+		return true
+	}
+
+	_, length := pointer.MethodByName("Length")
+	_, at := pointer.MethodByName("At")
+
+	return length && at
+}
+
+// arrayLikeMethods returns the length and the At method of a value with the methods Length() int and
+// At(int) T, such as a Deque, ImmutableArray or typed array.
+func arrayLikeMethods(v reflect.Value) (int, reflect.Value, bool) {
+	var (
+		length = v.MethodByName("Length")
+		at     = v.MethodByName("At")
+		intT   = reflect.TypeFor[int]()
+	)
+
+	if !length.IsValid() || !at.IsValid() {
+		return 0, reflect.Value{}, false
+	}
+
+	if lt := length.Type(); lt.NumIn() != 0 || lt.NumOut() != 1 || lt.Out(0) != intT {
+		return 0, reflect.Value{}, false
+	}
+
+	if at := at.Type(); at.NumIn() != 1 || at.In(0) != intT || at.NumOut() != 1 {
+		return 0, reflect.Value{}, false
+	}
+
+	return int(length.Call(nil)[0].Int()), at, true
+}
+
+// typeName returns the name of a type, or of the type it points to, without its package and type
+// arguments, or "" for an unnamed type.
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	name, _, _ := strings.Cut(t.Name(), "[")
+	return name
+}
+
+// isNumber reports whether the value, or the value held by an interface, is a number.
+func isNumber(v reflect.Value) bool {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// inspectNumber formats a floating-point number like JavaScript does, keeping the sign of negative zero,
+// and without the noise of widening a float32 to a float64.
+func inspectNumber(v reflect.Value) string {
+	f := v.Float()
+	if v.Kind() == reflect.Float32 && !math.IsInf(f, 0) && !math.IsNaN(f) {
+		f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'g', -1, 32), 64)
+	}
+
+	if f == 0 && math.Signbit(f) {
+		return "-0"
+	}
+
+	return formatJSNumber(f)
+}
+
+// quote quotes a string with single quotes, or with double quotes or backticks if it contains single
+// quotes, and escapes control characters, like util.inspect does.
+func quote(s string) string {
+	q := byte('\'')
+	if strings.IndexByte(s, '\'') >= 0 {
+		switch {
+		case strings.IndexByte(s, '"') < 0:
+			q = '"'
+		case strings.IndexByte(s, '`') < 0 && !strings.Contains(s, "${"):
+			q = '`'
+		}
+	}
+
+	var b strings.Builder
+	b.WriteByte(q)
+
+	for _, r := range s {
+		switch {
+		case r == rune(q) || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\b':
+			b.WriteString(`\b`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\f':
+			b.WriteString(`\f`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r < 0x20 || (r >= 0x7f && r <= 0x9f):
+			fmt.Fprintf(&b, `\x%02X`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	b.WriteByte(q)
+	return b.String()
+}
+
+// visibleLength returns the number of characters of a string as shown in a terminal, leaving out the
+// escape sequences of colours.
+func visibleLength(s string) int {
+	n := 0
+
+	for i := 0; i < len(s); {
+		if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '[' {
+			end := strings.IndexByte(s[i:], 'm')
+			if end >= 0 {
+				i += end + 1
+				continue
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		n++
+	}
+
+	return n
+}
+
+// emptyItems describes a run of holes in a sparse array.
+func emptyItems(n int) string {
+	if n == 1 {
+		return "<1 empty item>"
+	}
+
+	return fmt.Sprintf("<%d empty items>", n)
+}
+
+// moreItems describes the elements left out by MaxArrayLength.
+func moreItems(n int) string {
+	if n == 1 {
+		return "... 1 more item"
+	}
+
+	return fmt.Sprintf("... %d more items", n)
+}
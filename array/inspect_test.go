@@ -0,0 +1,160 @@
+package array
+
+import (
+	"fmt"
+	"testing"
+)
+
+// The expected layouts follow what util.inspect prints in Node.js for the same values.
+
+type inspectNode struct {
+	Name string
+	Next *inspectNode
+}
+
+// withOptions returns the default options changed by fn.
+func withOptions(fn func(options *InspectOptions)) InspectOptions {
+	options := DefaultInspectOptions()
+	fn(&options)
+
+	return options
+}
+
+func TestInspect(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		options InspectOptions
+		want    string
+	}{
+		{"short array", NewWithEntries([]any{1, "two", []int{3}, map[string]int{"four": 4}}), DefaultInspectOptions(),
+			"[ 1, 'two', [ 3 ], Map(1) { 'four' => 4 } ]"},
+		{"empty array", New[int](), DefaultInspectOptions(), "[]"},
+		{"array value", *NewWithEntries([]int{1, 2, 3}), DefaultInspectOptions(), "[ 1, 2, 3 ]"},
+		{"sparse array value", *NewSparse[int](3), DefaultInspectOptions(), "[ <3 empty items> ]"},
+		{"deque value", *NewDequeWithEntries([]int{1, 2}), DefaultInspectOptions(), "Deque(2) [ 1, 2 ]"},
+
+		{"default depth", []any{1, []any{2, []any{3, []any{4, []any{5}}}}}, DefaultInspectOptions(),
+			"[ 1, [ 2, [ 3, [Array] ] ] ]"},
+		{"depth 0", []any{1, []any{2}, map[string]int{"a": 1}, inspectNode{Name: "x"}},
+			withOptions(func(o *InspectOptions) { o.Depth = 0 }),
+			"[ 1, [Array], [Map], [inspectNode] ]"},
+		{"infinite depth", []any{[]any{[]any{[]any{1}}}}, withOptions(func(o *InspectOptions) { o.Depth = Infinity }),
+			"[\n  [ [ [ 1 ] ] ]\n]"},
+
+		{"maxArrayLength on an array", []int{1, 2, 3, 4}, withOptions(func(o *InspectOptions) { o.MaxArrayLength = 2 }),
+			"[ 1, 2, ... 2 more items ]"},
+		{"maxArrayLength on a map", map[string]int{"a": 1, "b": 2, "c": 3},
+			withOptions(func(o *InspectOptions) { o.MaxArrayLength = 2 }),
+			"Map(3) { 'a' => 1, 'b' => 2, ... 1 more item }"},
+		{"maxArrayLength of 0", []int{1, 2}, withOptions(func(o *InspectOptions) { o.MaxArrayLength = 0 }),
+			"[ ... 2 more items ]"},
+
+		{"breakLength", []string{"alpha", "beta", "gamma"}, withOptions(func(o *InspectOptions) { o.BreakLength = 20 }),
+			"[\n  'alpha',\n  'beta',\n  'gamma'\n]"},
+		{"long entries", []string{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "cccccccccccccccccccccccccccc"},
+			DefaultInspectOptions(),
+			"[\n  'aaaaaaaaaaaaaaaaaaaaaaaaaaaaa',\n  'bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb',\n  'cccccccccccccccccccccccccccc'\n]"},
+		{"grouped numbers", sequence(0, 26), DefaultInspectOptions(), "" +
+			"[\n" +
+			"   0,  1,  2,  3,  4,  5,  6,  7,\n" +
+			"   8,  9, 10, 11, 12, 13, 14, 15,\n" +
+			"  16, 17, 18, 19, 20, 21, 22, 23,\n" +
+			"  24, 25\n" +
+			"]"},
+		{"grouped with more items", sequence(0, 120), DefaultInspectOptions(), "" +
+			"[\n" +
+			"   0,  1,  2,  3,  4,  5,  6,  7,  8,  9, 10, 11,\n" +
+			"  12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23,\n" +
+			"  24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35,\n" +
+			"  36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47,\n" +
+			"  48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59,\n" +
+			"  60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71,\n" +
+			"  72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83,\n" +
+			"  84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95,\n" +
+			"  96, 97, 98, 99,\n" +
+			"  ... 20 more items\n" +
+			"]"},
+		{"grouped columns are padded to their widest entry", sequence(0, 101),
+			withOptions(func(o *InspectOptions) { o.MaxArrayLength = Infinity }), "" +
+				"[\n" +
+				"   0,  1,  2,  3,   4,  5,  6,  7,  8,  9, 10, 11,\n" +
+				"  12, 13, 14, 15,  16, 17, 18, 19, 20, 21, 22, 23,\n" +
+				"  24, 25, 26, 27,  28, 29, 30, 31, 32, 33, 34, 35,\n" +
+				"  36, 37, 38, 39,  40, 41, 42, 43, 44, 45, 46, 47,\n" +
+				"  48, 49, 50, 51,  52, 53, 54, 55, 56, 57, 58, 59,\n" +
+				"  60, 61, 62, 63,  64, 65, 66, 67, 68, 69, 70, 71,\n" +
+				"  72, 73, 74, 75,  76, 77, 78, 79, 80, 81, 82, 83,\n" +
+				"  84, 85, 86, 87,  88, 89, 90, 91, 92, 93, 94, 95,\n" +
+				"  96, 97, 98, 99, 100\n" +
+				"]"},
+		{"no grouping on a single line", sequence(0, 6), withOptions(func(o *InspectOptions) { o.BreakLength = Infinity }),
+			"[ 0, 1, 2, 3, 4, 5 ]"},
+	}
+
+	for _, tt := range tests {
+		if got := Inspect(tt.value, tt.options); got != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestInspectCircular(t *testing.T) {
+	first := &inspectNode{Name: "a"}
+	first.Next = &inspectNode{Name: "b", Next: first}
+
+	slice := []any{1, nil}
+	slice[1] = slice
+
+	arr := NewWithEntries([]any{1, "two"})
+	arr.Push(arr)
+
+	shared := []int{1}
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"struct", first, "<ref *1> inspectNode {\n  Name: 'a',\n  Next: inspectNode { Name: 'b', Next: [Circular *1] }\n}"},
+		{"slice", slice, "<ref *1> [ 1, [Circular *1] ]"},
+		{"array", arr, "<ref *1> [ 1, 'two', [Circular *1] ]"},
+		{"shared but not circular", [][]int{shared, shared}, "[ [ 1 ], [ 1 ] ]"},
+	}
+
+	for _, tt := range tests {
+		if got := Inspect(tt.value); got != tt.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	arr := NewWithEntries([]int{10, 255})
+	nested := NewWithEntries([]any{[]any{[]any{[]any{1}}}})
+
+	tests := []struct {
+		format string
+		value  any
+		want   string
+	}{
+		{"%v", arr, "[ 10, 255 ]"},
+		{"%s", arr, "[ 10, 255 ]"},
+		{"%v", nested, "[ [ [ [Array] ] ] ]"},
+		{"%+v", nested, "[\n  [ [ [ 1 ] ] ]\n]"},
+		{"%#v", arr, "array.NewWithEntries([]int{10, 255})"},
+		{"%x", arr, "[a ff]"},
+		{"%d", arr, "[10 255]"},
+		{"%v", NewWithEntries(sequence(0, 101)), Inspect(sequence(0, 101))},
+		{"%+v", NewWithEntries(sequence(0, 101)), Inspect(sequence(0, 101), withOptions(func(o *InspectOptions) {
+			o.Depth = Infinity
+			o.MaxArrayLength = Infinity
+		}))},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.value); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package array
+
+import "sync/atomic"
+
+// Logger receives the messages this package logs: the calls traced by SetTracing, at the DEBUG level,
+// and the arguments reported by PolicyLog, at the WARNING level. The level is one of INFO, DEBUG, WARNING
+// and ERROR. A Logger may be called from any goroutine.
+type Logger func(level int, message string)
+
+// logger holds the Logger set with SetLogger, or nil while the default one is in use.
+var logger atomic.Pointer[Logger]
+
+// SetLogger sets the function this package logs through and returns the previous one. Passing nil restores
+// the default, which writes each message to standard error behind a coloured level tag such as [DEBUG].
+// It is safe to call at any time from any goroutine.
+//
+// The loggergo package provides a Logger that writes through github.com/iVitaliya/logger-go. It is kept
+// out of this package because logger-go panics during initialisation in any process whose standard output
+// is not a terminal, so only programs that run in one should import it.
+//
+// Example:
+//
+//	array.SetLogger(loggergo.Log)
+//	array.SetTracing(1) // every traced call is now logged with logger.Debug
+func SetLogger(fn Logger) Logger {
+	var previous *Logger
+	if fn == nil {
+		previous = logger.Swap(nil)
+	} else {
+		previous = logger.Swap(&fn)
+	}
+
+	if previous == nil {
+		return defaultLogger
+	}
+
+	return *previous
+}
+
+// defaultLogger is the Logger used until SetLogger installs another one.
+func defaultLogger(level int, message string) {
+	print(level, message)
+}
+
+// log sends the message to the current Logger at the given level.
+func log(level int, message string) {
+	if fn := logger.Load(); fn != nil {
+		(*fn)(level, message)
+		return
+	}
+
+	defaultLogger(level, message)
+}
@@ -0,0 +1,31 @@
+// Package loggergo logs the messages of the array package through github.com/iVitaliya/logger-go.
+//
+// logger-go colours its output with colors-go while it initialises, which panics unless standard output is a
+// terminal or the FORCED environment variable is set. Importing this package therefore crashes services,
+// cron jobs and CI runs that do not set FORCED; it is meant for programs run from a terminal.
+//
+// Example:
+//
+//	array.SetLogger(loggergo.Log)
+//	array.SetTracing(1)
+package loggergo
+
+import (
+	"github.com/iVitaliya/javascript-go/array"
+	"github.com/iVitaliya/logger-go"
+)
+
+// Log is an array.Logger writing the message with the logger-go function for its level:
+// logger.Info, logger.Debug, logger.Warning or logger.Error.
+func Log(level int, message string) {
+	switch level {
+	case array.DEBUG:
+		logger.Debug(message)
+	case array.WARNING:
+		logger.Warning(message)
+	case array.ERROR:
+		logger.Error(message)
+	default:
+		logger.Info(message)
+	}
+}
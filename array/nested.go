@@ -0,0 +1,256 @@
+package array
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Infinity is a depth that flattens nested arrays completely, like passing Infinity to Array.prototype.flat.
+const Infinity = math.MaxInt
+
+// Nested is an element of a nested array: either a single value or a list of further nested elements,
+// the typed equivalent of a JavaScript array such as [1, [2, [3]]].
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]array.Nested[int]{
+//		array.NestedValue(1),
+//		array.NestedList(array.NestedValue(2), array.NestedList(array.NestedValue(3))),
+//	})
+//	flat, _ := array.FlatValues(arr, array.Infinity)
+//	// flat is now an array of type []int with elements 1, 2, 3
+type Nested[T any] struct {
+	value  T
+	items  []Nested[T]
+	isList bool
+}
+
+// NestedValue returns a nested element holding a single value.
+func NestedValue[T any](value T) Nested[T] {
+	return Nested[T]{
+		value: value,
+	}
+}
+
+// NestedList returns a nested element holding a list of the given elements.
+func NestedList[T any](items ...Nested[T]) Nested[T] {
+	return Nested[T]{
+		items:  cloneSlice(items),
+		isList: true,
+	}
+}
+
+// NestedValues returns a nested element holding a list of the given values.
+func NestedValues[T any](values ...T) Nested[T] {
+	items := make([]Nested[T], len(values))
+	for i, v := range values {
+		items[i] = NestedValue(v)
+	}
+
+	return Nested[T]{
+		items:  items,
+		isList: true,
+	}
+}
+
+// IsList reports whether the element is a list rather than a single value.
+func (nested Nested[T]) IsList() bool {
+	return nested.isList
+}
+
+// Items returns a copy of the elements of the list, or nil if the element is a single value.
+func (nested Nested[T]) Items() []Nested[T] {
+	if !nested.isList {
+		return nil
+	}
+
+	return cloneSlice(nested.items)
+}
+
+// Value returns the value of the element, and false if the element is a list.
+func (nested Nested[T]) Value() (T, bool) {
+	return nested.value, !nested.isList
+}
+
+// String formats the element like a JavaScript array literal, such as "[1, [2, 3]]" for a list.
+func (nested Nested[T]) String() string {
+	if !nested.isList {
+		return toJSString(nested.value)
+	}
+
+	parts := make([]string, len(nested.items))
+	for i, item := range nested.items {
+		parts[i] = item.String()
+	}
+
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// nestedItems returns the elements of the list as values of the dynamic type Nested[T], so that
+// Array.Flat can flatten an Array[Nested[T]] without knowing T.
+func (nested Nested[T]) nestedItems() ([]any, bool) {
+	if !nested.isList {
+		return nil, false
+	}
+
+	items := make([]any, len(nested.items))
+	for i, item := range nested.items {
+		items[i] = item
+	}
+
+	return items, true
+}
+
+// nestedList is implemented by every Nested type.
+type nestedList interface {
+	nestedItems() ([]any, bool)
+}
+
+// Flat returns a new array with the lists in the given nested array flattened into it, recursively up to
+// the given depth, like Array.prototype.flat. A depth of 1 flattens one level, Infinity flattens every level,
+// and a depth below 1 returns a copy of the array.
+// Use FlatValues to get the plain values out of a completely flattened array.
+func Flat[T any](array *Array[Nested[T]], depth int) *Array[Nested[T]] {
+	return &Array[Nested[T]]{
+		array: flattenNested([]Nested[T]{}, array.array, depth),
+		equal: array.equal,
+	}
+}
+
+// FlatValues flattens the given nested array up to the given depth and returns the values it holds.
+// If a list is still left after flattening, because it is nested deeper than the depth, it returns an
+// error wrapping ErrFlatten. Pass Infinity to flatten every level, which never fails.
+func FlatValues[T any](array *Array[Nested[T]], depth int) (*Array[T], error) {
+	flat := flattenNested([]Nested[T]{}, array.array, depth)
+
+	result := make([]T, len(flat))
+	for i, item := range flat {
+		if item.isList {
+			return nil, fmt.Errorf("%w: list %v at index %d is nested deeper than %d", ErrFlatten, item, i, depth)
+		}
+
+		result[i] = item.value
+	}
+
+	return &Array[T]{
+		array: result,
+	}, nil
+}
+
+// FlatMapNested calls the provided function on every element of the given array and flattens the results
+// exactly one level deep into a new array, like Array.prototype.flatMap: a list returned by the callback
+// contributes its elements, while a single value is added as it is. Lists nested inside the returned
+// list are kept as lists.
+func FlatMapNested[T, U any](array *Array[T], fn func(value T, index int, array *Array[T]) Nested[U]) *Array[Nested[U]] {
+	result := []Nested[U]{}
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		result = flattenNested(result, []Nested[U]{fn(array.array[i], i, array)}, 1)
+	}
+
+	return &Array[Nested[U]]{
+		array: result,
+	}
+}
+
+// flattenNested appends the elements to the result, flattening lists up to the given depth.
+func flattenNested[T any](result, elements []Nested[T], depth int) []Nested[T] {
+	for _, element := range elements {
+		if depth < 1 || !element.isList {
+			result = append(result, element)
+			continue
+		}
+
+		result = flattenNested(result, element.items, depth-1)
+	}
+
+	return result
+}
+
+// flattenInto appends the elements to the result, flattening those that are lists up to the given depth.
+func flattenInto[T any](result, elements []T, depth int) ([]T, error) {
+	for _, element := range elements {
+		if depth < 1 {
+			result = append(result, element)
+			continue
+		}
+
+		items, ok, err := flatItems(element)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			result = append(result, element)
+			continue
+		}
+
+		if result, err = flattenInto(result, items, depth-1); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// flatItems returns the elements of the value if it is a list, that is a Nested list, a slice or an array.
+// It returns an error wrapping ErrFlatten if one of the elements is not of type T.
+func flatItems[T any](value T) ([]T, bool, error) {
+	if nested, ok := any(value).(nestedList); ok {
+		values, ok := nested.nestedItems()
+		if !ok {
+			return nil, false, nil
+		}
+
+		// The items of a Nested[U] are Nested[U] values themselves, so they are always of type T.
+		items := make([]T, len(values))
+		for i, v := range values {
+			items[i] = v.(T)
+		}
+
+		return items, true, nil
+	}
+
+	rv := reflect.ValueOf(any(value))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false, nil
+	}
+
+	items := make([]T, rv.Len())
+	for i := range items {
+		item, err := valueAs[T](rv.Index(i), rv.Type())
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %w", ErrFlatten, err)
+		}
+
+		items[i] = item
+	}
+
+	return items, true, nil
+}
+
+// valueAs returns the value as a T, unwrapping interfaces as needed, or an error wrapping ErrElementType
+// naming the source it came from.
+func valueAs[T any](v reflect.Value, source reflect.Type) (T, error) {
+	var result T
+
+	converted, ok := assignable(v, reflect.TypeFor[T]())
+	if !ok {
+		return result, fmt.Errorf("%w: %s in %s is not %s", ErrElementType, v.Type(), source, reflect.TypeFor[T]())
+	}
+
+	reflect.ValueOf(&result).Elem().Set(converted)
+	return result, nil
+}
+
+// assignable returns the value in a form that can be assigned to the target type, unwrapping an interface
+// whose dynamic value is assignable, and reports whether there is one.
+func assignable(v reflect.Value, target reflect.Type) (reflect.Value, bool) {
+	if v.Kind() == reflect.Interface && !v.IsNil() && !v.Type().AssignableTo(target) {
+		v = v.Elem()
+	}
+
+	return v, v.Type().AssignableTo(target)
+}
@@ -0,0 +1,189 @@
+package array
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// nestedSample returns the nested array [1, [2, [3, [4]]], 5].
+func nestedSample() *Array[Nested[int]] {
+	return NewWithEntries([]Nested[int]{
+		NestedValue(1),
+		NestedList(NestedValue(2), NestedList(NestedValue(3), NestedValues(4))),
+		NestedValue(5),
+	})
+}
+
+func TestNested(t *testing.T) {
+	list := NestedList(NestedValue(1), NestedValues(2, 3))
+
+	if !list.IsList() || NestedValue(1).IsList() {
+		t.Error("IsList() reported the wrong kind of element")
+	}
+
+	if got := list.String(); got != "[1, [2, 3]]" {
+		t.Errorf("String() = %s, want [1, [2, 3]]", got)
+	}
+
+	if v, ok := NestedValue("a").Value(); v != "a" || !ok {
+		t.Errorf("Value() of a value = %q, %v, want a, true", v, ok)
+	}
+
+	if _, ok := list.Value(); ok {
+		t.Error("Value() of a list reported a value")
+	}
+
+	if NestedValue(1).Items() != nil {
+		t.Error("Items() of a value is not nil")
+	}
+
+	items := list.Items()
+	items[0] = NestedValue(9)
+	if list.String() != "[1, [2, 3]]" {
+		t.Error("changing the result of Items() changed the list")
+	}
+
+	if got := NestedList[int]().String(); got != "[]" {
+		t.Errorf("String() of an empty list = %s, want []", got)
+	}
+}
+
+func TestFlatNested(t *testing.T) {
+	tests := []struct {
+		depth int
+		want  string
+	}{
+		{-1, "[1, [2, [3, [4]]], 5]"},
+		{0, "[1, [2, [3, [4]]], 5]"},
+		{1, "[1, 2, [3, [4]], 5]"},
+		{2, "[1, 2, 3, [4], 5]"},
+		{3, "[1, 2, 3, 4, 5]"},
+		{Infinity, "[1, 2, 3, 4, 5]"},
+	}
+
+	for _, tt := range tests {
+		got := Flat(nestedSample(), tt.depth)
+
+		if s := NestedList(got.array...).String(); s != tt.want {
+			t.Errorf("Flat(%d) = %s, want %s", tt.depth, s, tt.want)
+		}
+	}
+
+	source := nestedSample()
+	Flat(source, Infinity)
+	if source.Length() != 3 {
+		t.Error("Flat changed the array it flattened")
+	}
+}
+
+func TestFlatValues(t *testing.T) {
+	tests := []struct {
+		depth int
+		want  string
+		err   error
+	}{
+		{Infinity, "[1 2 3 4 5]", nil},
+		{3, "[1 2 3 4 5]", nil},
+		{2, "", ErrFlatten},
+		{0, "", ErrFlatten},
+	}
+
+	for _, tt := range tests {
+		got, err := FlatValues(nestedSample(), tt.depth)
+
+		switch {
+		case tt.err != nil && (!errors.Is(err, tt.err) || got != nil):
+			t.Errorf("FlatValues(%d) = %v, %v, want %v", tt.depth, got, err, tt.err)
+		case tt.err == nil && (err != nil || fmt.Sprint(got.array) != tt.want):
+			t.Errorf("FlatValues(%d) = %v, %v, want %s", tt.depth, got, err, tt.want)
+		}
+	}
+}
+
+func TestArrayFlat(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []any
+		depth  int
+		want   string
+	}{
+		{"depth 1", []any{1, []any{2, []any{3}}}, 1, "[1 2 [3]]"},
+		{"depth 2", []any{1, []any{2, []any{3}}}, 2, "[1 2 3]"},
+		{"Infinity", []any{[]any{[]any{[]any{1}}}, 2}, Infinity, "[1 2]"},
+		{"depth 0 copies", []any{1, []any{2}}, 0, "[1 [2]]"},
+		{"negative depth copies", []any{1, []any{2}}, -3, "[1 [2]]"},
+		{"typed slices inside any", []any{[]int{1, 2}, [2]string{"a", "b"}}, 1, "[1 2 a b]"},
+		{"empty lists disappear", []any{[]any{}, 1, []int{}}, 1, "[1]"},
+		{"strings are not lists", []any{"ab", []any{"c"}}, Infinity, "[ab c]"},
+	}
+
+	for _, tt := range tests {
+		got, err := NewWithEntries(tt.values).Flat(tt.depth)
+		if err != nil || fmt.Sprint(got) != tt.want {
+			t.Errorf("%s: Flat() = %v, %v, want %s", tt.name, got, err, tt.want)
+		}
+	}
+
+	nested, err := nestedSample().Flat(Infinity)
+	if err != nil || NestedList(nested...).String() != "[1, 2, 3, 4, 5]" {
+		t.Errorf("Flat() of an Array[Nested[int]] = %v, %v, want [1, 2, 3, 4, 5]", nested, err)
+	}
+}
+
+func TestArrayFlatErrors(t *testing.T) {
+	// A []int cannot hold the ints of an element, so flattening it fails where JavaScript would succeed.
+	_, err := NewWithEntries([][]int{{1}, {2}}).Flat(1)
+	if !errors.Is(err, ErrFlatten) || !errors.Is(err, ErrElementType) {
+		t.Errorf("Flat(1) of an Array[[]int] returned %v, want ErrFlatten and ErrElementType", err)
+	}
+
+	// Depth 0 never looks inside the elements, so it cannot fail.
+	if got, err := NewWithEntries([][]int{{1}}).Flat(0); err != nil || len(got) != 1 {
+		t.Errorf("Flat(0) of an Array[[]int] = %v, %v, want a copy", got, err)
+	}
+
+	// An element of type any can hold anything, so flattening an Array[any] never fails.
+	if _, err := NewWithEntries([]any{[]any{[]int{1}}}).Flat(Infinity); err != nil {
+		t.Errorf("Flat(Infinity) of ints inside any failed: %v", err)
+	}
+
+	type named []string
+	if _, err := NewWithEntries([]named{{"a"}}).Flat(1); !errors.Is(err, ErrFlatten) {
+		t.Errorf("Flat(1) of an Array[named] returned %v, want ErrFlatten", err)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	arr := NewWithEntries([]int{1, 2, 3})
+
+	var indices []int
+	got := arr.FlatMap(func(value, index int, array *Array[int]) []int {
+		indices = append(indices, index)
+		if value == 2 {
+			return nil
+		}
+
+		return []int{value, value * 10}
+	})
+
+	if fmt.Sprint(got, indices) != "[1 10 3 30] [0 1 2]" {
+		t.Errorf("FlatMap() = %v with indices %v, want [1 10 3 30] with [0 1 2]", got, indices)
+	}
+
+	nested := FlatMapNested(arr, func(value, index int, array *Array[int]) Nested[string] {
+		switch value {
+		case 1:
+			return NestedValue("one")
+		case 2:
+			return NestedList(NestedValue("two"), NestedValues("deep"))
+		}
+
+		return NestedList[string]()
+	})
+
+	// Only one level is flattened, so the inner list is kept and the empty list disappears.
+	if s := NestedList(nested.array...).String(); s != "[one, two, [deep]]" {
+		t.Errorf("FlatMapNested() = %s, want [one, two, [deep]]", s)
+	}
+}
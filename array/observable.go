@@ -0,0 +1,443 @@
+package array
+
+import (
+	"iter"
+
+	"github.com/iVitaliya/javascript-go/internal/bounds"
+)
+
+// ChangeKind describes what kind of change an ObservableArray went through.
+type ChangeKind int
+
+const (
+	// ChangeInsert means elements were inserted at the index of the change.
+	ChangeInsert ChangeKind = iota
+	// ChangeRemove means elements were removed from the index of the change.
+	ChangeRemove
+	// ChangeUpdate means elements were replaced in place, without changing the length of the array.
+	ChangeUpdate
+	// ChangeSplice means elements were removed from the index of the change and others inserted in their place.
+	ChangeSplice
+	// ChangeSort means the whole array was sorted.
+	ChangeSort
+	// ChangeReverse means the whole array was reversed.
+	ChangeReverse
+)
+
+// String returns the name of the change kind.
+func (kind ChangeKind) String() string {
+	switch kind {
+	case ChangeInsert:
+		return "insert"
+	case ChangeRemove:
+		return "remove"
+	case ChangeUpdate:
+		return "update"
+	case ChangeSplice:
+		return "splice"
+	case ChangeSort:
+		return "sort"
+	case ChangeReverse:
+		return "reverse"
+	}
+
+	return "unknown"
+}
+
+// Change is a change made to an ObservableArray. Every change can be applied as a splice: starting at
+// Index, the elements in OldValues were replaced with the elements in NewValues. OldValues is empty for
+// inserts and NewValues is empty for removals; sorts and reverses cover the whole array.
+// The slices are copies and may be kept by subscribers.
+type Change[T any] struct {
+	Kind      ChangeKind
+	Index     int
+	OldValues []T
+	NewValues []T
+}
+
+// subscriber is a function subscribed to an ObservableArray.
+type subscriber[T any] struct {
+	fn     func(change Change[T])
+	active bool
+}
+
+// ObservableArray is an array that notifies its subscribers of every change made to it.
+// It has the mutating methods of Array, each of which emits a Change describing what happened,
+// and Transaction, which applies a group of changes and emits them as a single splice.
+// Subscribers are called synchronously, in the order they subscribed, after the change has been applied.
+// An ObservableArray is not safe for concurrent use.
+//
+// Example:
+//
+//	arr := array.NewObservable[string]()
+//	unsubscribe := arr.Subscribe(func(change array.Change[string]) {
+//		fmt.Println(change.Kind, change.Index, change.NewValues)
+//	})
+//	arr.Push("a") // prints "insert 0 [a]"
+//	unsubscribe()
+type ObservableArray[T any] struct {
+	array       *Array[T]
+	subscribers []*subscriber[T]
+}
+
+// NewObservable returns a new empty observable array.
+func NewObservable[T any]() *ObservableArray[T] {
+	return &ObservableArray[T]{
+		array: New[T](),
+	}
+}
+
+// NewObservableWithEntries creates a new observable array holding a copy of the given entries.
+func NewObservableWithEntries[T any](entries []T) *ObservableArray[T] {
+	return &ObservableArray[T]{
+		array: NewWithEntries[T](entries),
+	}
+}
+
+// Subscribe registers a function to be called with every change made to the array, and returns a function
+// that unsubscribes it again. Unsubscribing is safe to do more than once, and from within the subscriber.
+func (array *ObservableArray[T]) Subscribe(fn func(change Change[T])) (unsubscribe func()) {
+	sub := &subscriber[T]{
+		fn:     fn,
+		active: true,
+	}
+
+	array.subscribers = append(array.subscribers, sub)
+
+	return func() {
+		if !sub.active {
+			return
+		}
+
+		sub.active = false
+
+		for i, s := range array.subscribers {
+			if s == sub {
+				array.subscribers = append(array.subscribers[:i:i], array.subscribers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Array returns a copy of the current elements of the array.
+func (array *ObservableArray[T]) Array() *Array[T] {
+	return array.array.clone()
+}
+
+// At returns the value at the given index. See Array.At.
+func (array *ObservableArray[T]) At(index int) T {
+	return array.array.At(index)
+}
+
+// Entries returns an iterator over the index/value pairs of the array. See Array.Entries.
+func (array *ObservableArray[T]) Entries() iter.Seq2[int, T] {
+	return array.array.Entries()
+}
+
+// Includes determines whether the array includes a certain element. See Array.Includes.
+func (array *ObservableArray[T]) Includes(search_term T, fromIndex ...int) bool {
+	return array.array.Includes(search_term, fromIndex...)
+}
+
+// IndexOf returns the first index of the given element, or -1 if it is not present. See Array.IndexOf.
+func (array *ObservableArray[T]) IndexOf(search_term T, fromIndex ...int) int {
+	return array.array.IndexOf(search_term, fromIndex...)
+}
+
+// Join joins all elements of the array into a string. See Array.Join.
+func (array *ObservableArray[T]) Join(separator string) string {
+	return array.array.Join(separator)
+}
+
+// Length returns the number of elements in the array.
+func (array *ObservableArray[T]) Length() int {
+	return array.array.Length()
+}
+
+// ToString returns a string representation of the array. See Array.ToString.
+func (array *ObservableArray[T]) ToString() string {
+	return array.array.ToString()
+}
+
+// Values returns an iterator over the elements of the array. See Array.Values.
+func (array *ObservableArray[T]) Values() iter.Seq[T] {
+	return array.array.Values()
+}
+
+// Append adds the given values to the end of the array and emits an insert.
+func (array *ObservableArray[T]) Append(value ...T) {
+	array.insert(len(array.array.array), value)
+}
+
+// Concat appends the elements of the given slices to the end of the array and emits a single insert.
+func (array *ObservableArray[T]) Concat(elements ...[]T) {
+	var values []T
+	for _, v := range elements {
+		values = append(values, v...)
+	}
+
+	array.insert(len(array.array.array), values)
+}
+
+// CopyWithin copies a part of the array to another location in it and emits an update for the elements
+// that were overwritten. See Array.CopyWithin.
+func (array *ObservableArray[T]) CopyWithin(target, start int, end ...int) []T {
+	var (
+		length = len(array.array.array)
+		to     = bounds.Clamp(target, length)
+		count  = min(bounds.End(end, length)-bounds.Clamp(start, length), length-to)
+	)
+
+	if count <= 0 {
+		return cloneSlice(array.array.array)
+	}
+
+	old := cloneSlice(array.array.array[to : to+count])
+	array.array.CopyWithin(target, start, end...)
+	array.update(to, old)
+
+	return cloneSlice(array.array.array)
+}
+
+// Fill fills the elements of the array from a start index to an end index with a static value,
+// and emits an update for the filled elements. See Array.Fill.
+func (array *ObservableArray[T]) Fill(element T, start int, end ...int) []T {
+	var (
+		length = len(array.array.array)
+		from   = bounds.Clamp(start, length)
+		final  = bounds.End(end, length)
+	)
+
+	if from >= final {
+		return cloneSlice(array.array.array)
+	}
+
+	old := cloneSlice(array.array.array[from:final])
+	array.array.Fill(element, start, end...)
+	array.update(from, old)
+
+	return cloneSlice(array.array.array)
+}
+
+// Pop removes the last element from the array and returns it, emitting a remove.
+// If the array is empty, it returns a zero value of type T and emits nothing.
+func (array *ObservableArray[T]) Pop() T {
+	length := len(array.array.array)
+	if length == 0 {
+		return *new(T)
+	}
+
+	result := array.array.Pop()
+	array.emit(Change[T]{
+		Kind:      ChangeRemove,
+		Index:     length - 1,
+		OldValues: []T{result},
+		NewValues: []T{},
+	})
+
+	return result
+}
+
+// Push adds the given value to the end of the array, emits an insert and returns the new length.
+func (array *ObservableArray[T]) Push(value T) int {
+	array.insert(len(array.array.array), []T{value})
+
+	return len(array.array.array)
+}
+
+// Reverse reverses the elements of the array in place and emits a reverse.
+func (array *ObservableArray[T]) Reverse() {
+	old := cloneSlice(array.array.array)
+
+	array.array.Reverse()
+	array.reorder(ChangeReverse, old)
+}
+
+// Set replaces the value at the given index and emits an update.
+// Negative indices count back from the end of the array. If the index is out of range, it returns a *RangeError.
+func (array *ObservableArray[T]) Set(index int, value T) error {
+	k, err := checkIndex("Set", index, len(array.array.array))
+	if err != nil {
+		return err
+	}
+
+	old := []T{array.array.array[k]}
+	array.array.array[k] = value
+	array.update(k, old)
+
+	return nil
+}
+
+// Shift removes the first element from the array and returns it, emitting a remove.
+// If the array is empty, it returns a zero value of type T and emits nothing.
+func (array *ObservableArray[T]) Shift() T {
+	if len(array.array.array) == 0 {
+		return *new(T)
+	}
+
+	result := array.array.Shift()
+	array.emit(Change[T]{
+		Kind:      ChangeRemove,
+		Index:     0,
+		OldValues: []T{result},
+		NewValues: []T{},
+	})
+
+	return result
+}
+
+// Sort sorts the elements of the array in place and emits a sort. See Array.Sort.
+func (array *ObservableArray[T]) Sort(fn ...func(a, b T) int) {
+	old := cloneSlice(array.array.array)
+
+	array.array.Sort(fn...)
+	array.reorder(ChangeSort, old)
+}
+
+// Splice removes, replaces or inserts elements in place and returns the removed elements. See Array.Splice.
+// It emits an insert if elements were only inserted, a remove if they were only removed, and a splice if both.
+func (array *ObservableArray[T]) Splice(start, deleteCount int, items ...T) *Array[T] {
+	index, _ := spliceBounds(start, deleteCount, len(array.array.array))
+	removed := array.array.Splice(start, deleteCount, items...)
+
+	kind := ChangeSplice
+	switch {
+	case len(removed.array) == 0 && len(items) == 0:
+		return removed
+	case len(removed.array) == 0:
+		kind = ChangeInsert
+	case len(items) == 0:
+		kind = ChangeRemove
+	}
+
+	array.emit(Change[T]{
+		Kind:      kind,
+		Index:     index,
+		OldValues: cloneSlice(removed.array),
+		NewValues: cloneSlice(items),
+	})
+
+	return removed
+}
+
+// Unshift adds one or more elements to the beginning of the array, emits an insert and returns the new length.
+func (array *ObservableArray[T]) Unshift(elements ...T) int {
+	array.insert(0, elements)
+
+	return len(array.array.array)
+}
+
+// SetEquality changes the equality function used to compare elements by the search methods.
+// Passing nil restores the default SameValueZero comparison.
+// The return value is the array itself, so the call can be chained.
+func (array *ObservableArray[T]) SetEquality(equal Equality[T]) *ObservableArray[T] {
+	array.array.SetEquality(equal)
+
+	return array
+}
+
+// Transaction calls the provided function with a working copy of the array. If the function returns nil,
+// the copy replaces the array and a single splice is emitted covering everything that changed, found by
+// trimming the elements the old and new arrays have in common at their start and end. The elements are
+// compared with SameValueZero rather than the equality function of the array, so that replacing an element
+// with one the function merely considers equal, such as "a" with "A" under strings.EqualFold, is still
+// emitted. If nothing changed, nothing is emitted. If the function returns an error, the array is left as it was and nothing is emitted.
+// The function must not call back into the ObservableArray.
+//
+// Example:
+//
+//	arr := array.NewObservableWithEntries([]int{1, 2, 3, 4})
+//	arr.Transaction(func(working *array.Array[int]) error {
+//		working.Splice(1, 1)
+//		working.Splice(1, 1, 7, 8)
+//		return nil
+//	})
+//	// emits one change: splice at index 1, [2 3] replaced with [7 8]
+func (array *ObservableArray[T]) Transaction(fn func(array *Array[T]) error) error {
+	working := array.array.clone()
+	if err := fn(working); err != nil {
+		return err
+	}
+
+	var (
+		before = array.array.array
+		after  = working.array
+		prefix = 0
+		suffix = 0
+	)
+
+	equal := sameValueZero[T]()
+	for prefix < len(before) && prefix < len(after) && equal(before[prefix], after[prefix]) {
+		prefix++
+	}
+
+	for suffix < len(before)-prefix && suffix < len(after)-prefix &&
+		equal(before[len(before)-1-suffix], after[len(after)-1-suffix]) {
+		suffix++
+	}
+
+	array.array = working
+
+	if prefix == len(before) && prefix == len(after) {
+		return nil
+	}
+
+	array.emit(Change[T]{
+		Kind:      ChangeSplice,
+		Index:     prefix,
+		OldValues: cloneSlice(before[prefix : len(before)-suffix]),
+		NewValues: cloneSlice(after[prefix : len(after)-suffix]),
+	})
+
+	return nil
+}
+
+// insert inserts the values at the index and emits an insert, unless there are no values.
+func (array *ObservableArray[T]) insert(index int, values []T) {
+	if len(values) == 0 {
+		return
+	}
+
+	array.array.array = spliceInto(array.array.array, index, 0, values)
+	array.emit(Change[T]{
+		Kind:      ChangeInsert,
+		Index:     index,
+		OldValues: []T{},
+		NewValues: cloneSlice(values),
+	})
+}
+
+// update emits an update for the elements starting at the index that previously held the old values.
+func (array *ObservableArray[T]) update(index int, old []T) {
+	array.emit(Change[T]{
+		Kind:      ChangeUpdate,
+		Index:     index,
+		OldValues: old,
+		NewValues: cloneSlice(array.array.array[index : index+len(old)]),
+	})
+}
+
+// reorder emits a sort or reverse covering the whole array, unless the array is empty.
+func (array *ObservableArray[T]) reorder(kind ChangeKind, old []T) {
+	if len(old) == 0 {
+		return
+	}
+
+	array.emit(Change[T]{
+		Kind:      kind,
+		Index:     0,
+		OldValues: old,
+		NewValues: cloneSlice(array.array.array),
+	})
+}
+
+// emit calls every active subscriber with the change. Subscribers added while the change is being
+// delivered only receive later changes, and subscribers removed meanwhile are skipped.
+func (array *ObservableArray[T]) emit(change Change[T]) {
+	for _, sub := range cloneSlice(array.subscribers) {
+		if sub.active {
+			sub.fn(change)
+		}
+	}
+}
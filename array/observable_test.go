@@ -0,0 +1,190 @@
+package array
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// record subscribes to the array and returns the changes it emits, each shown as "kind index old new".
+func record[T any](arr *ObservableArray[T]) *[]string {
+	changes := &[]string{}
+	arr.Subscribe(func(change Change[T]) {
+		*changes = append(*changes, fmt.Sprint(change.Kind, " ", change.Index, " ", change.OldValues, " ", change.NewValues))
+	})
+
+	return changes
+}
+
+func TestObservableEvents(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(arr *ObservableArray[int])
+		want string
+	}{
+		{"Push", func(arr *ObservableArray[int]) { arr.Push(4) }, "insert 3 [] [4]"},
+		{"Append", func(arr *ObservableArray[int]) { arr.Append(4, 5) }, "insert 3 [] [4 5]"},
+		{"Append nothing", func(arr *ObservableArray[int]) { arr.Append() }, ""},
+		{"Concat", func(arr *ObservableArray[int]) { arr.Concat([]int{4}, []int{5}) }, "insert 3 [] [4 5]"},
+		{"Unshift", func(arr *ObservableArray[int]) { arr.Unshift(0) }, "insert 0 [] [0]"},
+		{"Pop", func(arr *ObservableArray[int]) { arr.Pop() }, "remove 2 [3] []"},
+		{"Shift", func(arr *ObservableArray[int]) { arr.Shift() }, "remove 0 [1] []"},
+		{"Set", func(arr *ObservableArray[int]) { arr.Set(-1, 9) }, "update 2 [3] [9]"},
+		{"Set out of range", func(arr *ObservableArray[int]) { arr.Set(3, 9) }, ""},
+		{"Fill", func(arr *ObservableArray[int]) { arr.Fill(0, 1) }, "update 1 [2 3] [0 0]"},
+		{"Fill an empty range", func(arr *ObservableArray[int]) { arr.Fill(0, 2, 1) }, ""},
+		{"CopyWithin", func(arr *ObservableArray[int]) { arr.CopyWithin(0, 1) }, "update 0 [1 2] [2 3]"},
+		{"Splice remove", func(arr *ObservableArray[int]) { arr.Splice(1, 1) }, "remove 1 [2] []"},
+		{"Splice insert", func(arr *ObservableArray[int]) { arr.Splice(1, 0, 7) }, "insert 1 [] [7]"},
+		{"Splice replace", func(arr *ObservableArray[int]) { arr.Splice(-2, 1, 7, 8) }, "splice 1 [2] [7 8]"},
+		{"Splice nothing", func(arr *ObservableArray[int]) { arr.Splice(1, 0) }, ""},
+		{"Reverse", func(arr *ObservableArray[int]) { arr.Reverse() }, "reverse 0 [1 2 3] [3 2 1]"},
+		{"Sort", func(arr *ObservableArray[int]) { arr.Sort(func(a, b int) int { return b - a }) }, "sort 0 [1 2 3] [3 2 1]"},
+	}
+
+	for _, tt := range tests {
+		arr := NewObservableWithEntries([]int{1, 2, 3})
+		changes := record(arr)
+
+		tt.run(arr)
+
+		if got := strings.Join(*changes, "; "); got != tt.want {
+			t.Errorf("%s emitted %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	empty := NewObservable[int]()
+	changes := record(empty)
+	empty.Pop()
+	empty.Shift()
+	empty.Reverse()
+	empty.Sort()
+
+	if len(*changes) != 0 {
+		t.Errorf("changes to an empty array emitted %q, want nothing", *changes)
+	}
+}
+
+func TestObservableUnsubscribe(t *testing.T) {
+	arr := NewObservable[int]()
+
+	var (
+		calls          []string
+		unsubscribeB   func()
+		unsubscribeA   func()
+		subscribedLate bool
+	)
+
+	unsubscribeA = arr.Subscribe(func(change Change[int]) {
+		calls = append(calls, "a")
+
+		// Unsubscribing itself and a later subscriber while the change is delivered.
+		unsubscribeA()
+		unsubscribeB()
+
+		// Subscribing during delivery only receives later changes.
+		if !subscribedLate {
+			subscribedLate = true
+			arr.Subscribe(func(change Change[int]) { calls = append(calls, "late") })
+		}
+	})
+
+	unsubscribeB = arr.Subscribe(func(change Change[int]) { calls = append(calls, "b") })
+	arr.Subscribe(func(change Change[int]) { calls = append(calls, "c") })
+
+	arr.Push(1)
+	arr.Push(2)
+
+	if got, want := strings.Join(calls, " "), "a c c late"; got != want {
+		t.Errorf("subscribers were called as %q, want %q", got, want)
+	}
+
+	unsubscribeA()
+	unsubscribeB()
+
+	if len(arr.subscribers) != 2 {
+		t.Errorf("unsubscribing twice left %d subscribers, want 2", len(arr.subscribers))
+	}
+}
+
+func TestObservableTransaction(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(working *Array[int]) error
+		want string
+		then string
+	}{
+		{"splices are merged", func(working *Array[int]) error {
+			working.Splice(1, 1)
+			working.Splice(1, 1, 7, 8)
+			return nil
+		}, "splice 1 [2 3] [7 8]", "[1 7 8 4]"},
+		{"pushes", func(working *Array[int]) error {
+			working.Push(5)
+			working.Push(6)
+			return nil
+		}, "splice 4 [] [5 6]", "[1 2 3 4 5 6]"},
+		{"removals at the start", func(working *Array[int]) error {
+			working.Shift()
+			working.Shift()
+			return nil
+		}, "splice 0 [1 2] []", "[3 4]"},
+		{"repeated elements", func(working *Array[int]) error {
+			working.Splice(1, 0, 1)
+			return nil
+		}, "splice 1 [] [1]", "[1 1 2 3 4]"},
+		{"no change", func(working *Array[int]) error {
+			working.Reverse()
+			working.Reverse()
+			return nil
+		}, "", "[1 2 3 4]"},
+		{"error", func(working *Array[int]) error {
+			working.Push(5)
+			return errors.New("abort")
+		}, "", "[1 2 3 4]"},
+	}
+
+	for _, tt := range tests {
+		arr := NewObservableWithEntries([]int{1, 2, 3, 4})
+		changes := record(arr)
+
+		arr.Transaction(tt.run)
+
+		if got := strings.Join(*changes, "; "); got != tt.want {
+			t.Errorf("%s emitted %q, want %q", tt.name, got, tt.want)
+		}
+
+		if got := fmt.Sprint(arr.array.array); got != tt.then {
+			t.Errorf("%s left %s, want %s", tt.name, got, tt.then)
+		}
+	}
+}
+
+func TestObservableTransactionIgnoresEquality(t *testing.T) {
+	arr := NewObservableWithEntries([]string{"a", "b", "c"}).SetEquality(strings.EqualFold)
+	changes := record(arr)
+
+	arr.Transaction(func(working *Array[string]) error {
+		working.array[1] = "B"
+		return nil
+	})
+
+	if got, want := strings.Join(*changes, "; "), "splice 1 [b] [B]"; got != want {
+		t.Errorf("replacing an element the equality calls equal emitted %q, want %q", got, want)
+	}
+
+	if !arr.Includes("C") {
+		t.Error("the equality function was lost by the transaction")
+	}
+
+	floats := NewObservableWithEntries([]float64{math.NaN(), 1})
+	changes = record(floats)
+
+	floats.Transaction(func(working *Array[float64]) error { return nil })
+
+	if len(*changes) != 0 {
+		t.Errorf("a transaction keeping NaN emitted %q, want nothing", *changes)
+	}
+}
@@ -0,0 +1,225 @@
+package array
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelMap calls the provided function on every element of the given array using at most limit goroutines,
+// and returns a new array with the results in the same order as the original elements.
+// A limit of 0 or less uses runtime.GOMAXPROCS(0) goroutines.
+//
+// The context passed to the callback is cancelled as soon as any callback returns an error or the parent
+// context is done; no further elements are started after that, and the first error is returned.
+//
+// Example:
+//
+//	sizes, err := array.ParallelMap(ctx, urls, 8, func(ctx context.Context, url string, _ int) (int, error) {
+//		return fetchSize(ctx, url)
+//	})
+func ParallelMap[T, U any](ctx context.Context, array *Array[T], limit int, fn func(ctx context.Context, value T, index int) (U, error)) (*Array[U], error) {
+	var (
+		values = array.array
+		result = make([]U, len(values))
+	)
+
+	err := parallelFor(ctx, len(values), limit, func(ctx context.Context, i int) error {
+		v, err := fn(ctx, values[i], i)
+		if err != nil {
+			return err
+		}
+
+		result[i] = v
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Array[U]{
+		array: result,
+	}, nil
+}
+
+// ParallelFilter tests every element of the given array with the provided function using at most limit goroutines,
+// and returns a new array with the elements that passed, in the same order as in the original array.
+// Cancellation, limits and errors behave as described for ParallelMap.
+func ParallelFilter[T any](ctx context.Context, array *Array[T], limit int, fn func(ctx context.Context, value T, index int) (bool, error)) (*Array[T], error) {
+	var (
+		values = array.array
+		keep   = make([]bool, len(values))
+	)
+
+	err := parallelFor(ctx, len(values), limit, func(ctx context.Context, i int) error {
+		ok, err := fn(ctx, values[i], i)
+		if err != nil {
+			return err
+		}
+
+		keep[i] = ok
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []T{}
+	for i, ok := range keep {
+		if ok {
+			result = append(result, values[i])
+		}
+	}
+
+	return &Array[T]{
+		array: result,
+		equal: array.equal,
+	}, nil
+}
+
+// ParallelForEach calls the provided function once for every element of the given array using at most
+// limit goroutines. The order in which the calls happen is unspecified.
+// Cancellation, limits and errors behave as described for ParallelMap.
+func ParallelForEach[T any](ctx context.Context, array *Array[T], limit int, fn func(ctx context.Context, value T, index int) error) error {
+	values := array.array
+
+	return parallelFor(ctx, len(values), limit, func(ctx context.Context, i int) error {
+		return fn(ctx, values[i], i)
+	})
+}
+
+// ParallelReduce reduces the given array to a single value using at most limit goroutines.
+// The array is split into contiguous chunks which are reduced concurrently from left to right,
+// after which the chunk results are combined in order. The provided function must therefore be
+// associative, such as addition or taking a maximum, for the result to match a sequential Reduce.
+// Like Array.Reduce, each chunk starts from its first element, and an empty array returns a zero value of type T.
+// Cancellation, limits and errors behave as described for ParallelMap.
+func ParallelReduce[T any](ctx context.Context, array *Array[T], limit int, fn func(ctx context.Context, accumulator T, value T) (T, error)) (T, error) {
+	var (
+		values = array.array
+		chunks = parallelLimit(limit, len(values))
+	)
+
+	if len(values) == 0 {
+		return *new(T), nil
+	}
+
+	var (
+		size    = (len(values) + chunks - 1) / chunks
+		partial = make([]T, (len(values)+size-1)/size)
+	)
+
+	err := parallelFor(ctx, len(partial), chunks, func(ctx context.Context, c int) error {
+		var (
+			start = c * size
+			end   = start + size
+		)
+
+		if end > len(values) {
+			end = len(values)
+		}
+
+		acc := values[start]
+		for i := start + 1; i < end; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			v, err := fn(ctx, acc, values[i])
+			if err != nil {
+				return err
+			}
+
+			acc = v
+		}
+
+		partial[c] = acc
+		return nil
+	})
+	if err != nil {
+		return *new(T), err
+	}
+
+	result := partial[0]
+	for _, v := range partial[1:] {
+		if result, err = fn(ctx, result, v); err != nil {
+			return *new(T), err
+		}
+	}
+
+	return result, nil
+}
+
+// parallelFor calls fn for every index in [0, n) using at most limit goroutines.
+// The first error returned by fn cancels the context passed to the remaining calls and is returned;
+// if the parent context is done before every index completed, its error is returned instead.
+func parallelFor(ctx context.Context, n, limit int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var (
+		wg      sync.WaitGroup
+		next    atomic.Int64
+		done    atomic.Int64
+		once    sync.Once
+		failure error
+	)
+
+	workers := parallelLimit(limit, n)
+	wg.Add(workers)
+
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= n || ctx.Err() != nil {
+					return
+				}
+
+				if err := fn(ctx, i); err != nil {
+					once.Do(func() {
+						failure = err
+						cancel(err)
+					})
+
+					return
+				}
+
+				done.Add(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if failure != nil {
+		return failure
+	}
+
+	if int(done.Load()) < n {
+		return context.Cause(ctx)
+	}
+
+	return nil
+}
+
+// parallelLimit resolves the number of goroutines to use for n items: the given limit, or
+// runtime.GOMAXPROCS(0) when it is 0 or less, but never more than n and never less than 1.
+func parallelLimit(limit, n int) int {
+	if limit <= 0 {
+		limit = runtime.GOMAXPROCS(0)
+	}
+
+	if limit > n {
+		limit = n
+	}
+
+	if limit < 1 {
+		limit = 1
+	}
+
+	return limit
+}
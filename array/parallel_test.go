@@ -0,0 +1,225 @@
+package array
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// These tests are meant to be run with -race, like those of SyncArray.
+
+// limitTracker records how many callbacks run at the same time.
+type limitTracker struct {
+	running, peak atomic.Int64
+}
+
+// enter marks a callback as running until the returned function is called.
+func (tracker *limitTracker) enter() func() {
+	n := tracker.running.Add(1)
+	for {
+		peak := tracker.peak.Load()
+		if n <= peak || tracker.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+
+	// Give the other workers time to start, so that running over the limit would show.
+	time.Sleep(time.Millisecond)
+
+	return func() { tracker.running.Add(-1) }
+}
+
+func TestParallelMapOrder(t *testing.T) {
+	arr := NewWithEntries(sequence(0, 200))
+
+	got, err := ParallelMap(context.Background(), arr, 8, func(ctx context.Context, value, index int) (int, error) {
+		if value != index {
+			t.Errorf("callback got value %d at index %d", value, index)
+		}
+
+		// Finish the later elements first, so that the results arrive out of order.
+		time.Sleep(time.Duration(200-index) * time.Microsecond)
+		return value * 2, nil
+	})
+
+	if err != nil {
+		t.Fatalf("ParallelMap failed: %v", err)
+	}
+
+	for i, v := range got.array {
+		if v != i*2 {
+			t.Fatalf("ParallelMap()[%d] = %d, want %d", i, v, i*2)
+		}
+	}
+}
+
+func TestParallelFilterOrder(t *testing.T) {
+	arr := NewWithEntries(sequence(0, 100))
+
+	got, err := ParallelFilter(context.Background(), arr, 4, func(ctx context.Context, value, index int) (bool, error) {
+		time.Sleep(time.Duration(100-index) * time.Microsecond)
+		return value%3 == 0, nil
+	})
+
+	if err != nil {
+		t.Fatalf("ParallelFilter failed: %v", err)
+	}
+
+	want := NewWithEntries(sequence(0, 100)).Filter(func(value, index int, array *Array[int]) bool {
+		return value%3 == 0
+	})
+
+	if !slices.Equal(got.array, want) {
+		t.Errorf("ParallelFilter() = %v, want %v", got.array, want)
+	}
+}
+
+func TestParallelLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		run   func(ctx context.Context, arr *Array[int], limit int, tracker *limitTracker) error
+	}{
+		{"ParallelMap", 3, func(ctx context.Context, arr *Array[int], limit int, tracker *limitTracker) error {
+			_, err := ParallelMap(ctx, arr, limit, func(ctx context.Context, value, index int) (int, error) {
+				defer tracker.enter()()
+				return value, nil
+			})
+			return err
+		}},
+		{"ParallelFilter", 2, func(ctx context.Context, arr *Array[int], limit int, tracker *limitTracker) error {
+			_, err := ParallelFilter(ctx, arr, limit, func(ctx context.Context, value, index int) (bool, error) {
+				defer tracker.enter()()
+				return true, nil
+			})
+			return err
+		}},
+		{"ParallelForEach", 1, func(ctx context.Context, arr *Array[int], limit int, tracker *limitTracker) error {
+			return ParallelForEach(ctx, arr, limit, func(ctx context.Context, value, index int) error {
+				defer tracker.enter()()
+				return nil
+			})
+		}},
+		{"ParallelReduce", 4, func(ctx context.Context, arr *Array[int], limit int, tracker *limitTracker) error {
+			_, err := ParallelReduce(ctx, arr, limit, func(ctx context.Context, accumulator, value int) (int, error) {
+				defer tracker.enter()()
+				return accumulator + value, nil
+			})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		var tracker limitTracker
+
+		if err := tt.run(context.Background(), NewWithEntries(sequence(0, 40)), tt.limit, &tracker); err != nil {
+			t.Fatalf("%s failed: %v", tt.name, err)
+		}
+
+		if peak := tracker.peak.Load(); peak > int64(tt.limit) || peak < 1 {
+			t.Errorf("%s with a limit of %d ran %d callbacks at once", tt.name, tt.limit, peak)
+		}
+	}
+}
+
+func TestParallelFirstError(t *testing.T) {
+	var (
+		errFirst = errors.New("first")
+		calls    atomic.Int64
+	)
+
+	err := ParallelForEach(context.Background(), NewWithEntries(sequence(0, 1000)), 4, func(ctx context.Context, value, index int) error {
+		calls.Add(1)
+
+		if index == 10 {
+			return errFirst
+		}
+
+		if index > 10 {
+			// Later failures come after the first one was reported, and must not replace it.
+			<-ctx.Done()
+			return errors.New("later")
+		}
+
+		return nil
+	})
+
+	if !errors.Is(err, errFirst) {
+		t.Errorf("ParallelForEach returned %v, want the first error", err)
+	}
+
+	if n := calls.Load(); n >= 1000 {
+		t.Errorf("ParallelForEach kept starting elements after an error: %d calls", n)
+	}
+
+	_, err = ParallelMap(context.Background(), NewWithEntries([]int{1, 2, 3}), 2, func(ctx context.Context, value, index int) (string, error) {
+		if value == 2 {
+			return "", errFirst
+		}
+
+		return "ok", nil
+	})
+
+	if !errors.Is(err, errFirst) {
+		t.Errorf("ParallelMap returned %v, want the error of the callback", err)
+	}
+}
+
+func TestParallelCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int64
+
+	err := ParallelForEach(ctx, NewWithEntries(sequence(0, 1000)), 2, func(ctx context.Context, value, index int) error {
+		if calls.Add(1) == 5 {
+			cancel()
+		}
+
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelForEach returned %v after the context was cancelled, want context.Canceled", err)
+	}
+
+	if n := calls.Load(); n >= 1000 {
+		t.Errorf("ParallelForEach ran every element after the context was cancelled")
+	}
+
+	_, err = ParallelReduce(ctx, NewWithEntries(sequence(0, 100)), 2, func(ctx context.Context, accumulator, value int) (int, error) {
+		t.Error("ParallelReduce called its function with a cancelled context")
+		return 0, nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelReduce returned %v with a cancelled context, want context.Canceled", err)
+	}
+}
+
+func TestParallelReduce(t *testing.T) {
+	tests := []struct {
+		values []int
+		limit  int
+		want   int
+	}{
+		{nil, 4, 0},
+		{[]int{7}, 4, 7},
+		{sequence(1, 101), 1, 5050},
+		{sequence(1, 101), 3, 5050},
+		{sequence(1, 101), 0, 5050},
+		{sequence(1, 4), 10, 6},
+	}
+
+	for _, tt := range tests {
+		got, err := ParallelReduce(context.Background(), NewWithEntries(tt.values), tt.limit, func(ctx context.Context, accumulator, value int) (int, error) {
+			return accumulator + value, nil
+		})
+
+		if err != nil || got != tt.want {
+			t.Errorf("ParallelReduce(%d values, limit %d) = %d, %v, want %d", len(tt.values), tt.limit, got, err, tt.want)
+		}
+	}
+}
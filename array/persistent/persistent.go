@@ -0,0 +1,433 @@
+// Package persistent provides arrays that are saved to a key/value store and survive between runs of a program.
+//
+// The stores of this package are kept in plain Go maps. The maps of store-go cannot back them: store-go logs
+// through logger-go, whose package initialisation panics in any process whose standard output is not a
+// terminal, so merely linking it would crash services, cron jobs and CI runs. A store-go map, or any other
+// key/value store, can still be used by implementing the two methods of Store on top of it.
+package persistent
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	jsarray "github.com/iVitaliya/javascript-go/array"
+)
+
+// Store is a key/value store that an Array saves its elements to.
+// Values are the JSON encoding of the array.
+type Store interface {
+	// Load returns the data saved under the key, and whether the key exists.
+	Load(key string) ([]byte, bool, error)
+	// Save stores the data under the key, replacing any previous data.
+	Save(key string, data []byte) error
+}
+
+// MemoryStore is a Store kept in memory. Its contents live as long as the process does.
+// A MemoryStore is safe for concurrent use.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries map[string][]byte
+}
+
+// NewMemoryStore returns a new empty in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: map[string][]byte{},
+	}
+}
+
+// Load returns the data saved under the key, and whether the key exists.
+func (store *MemoryStore) Load(key string) ([]byte, bool, error) {
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+
+	data, ok := store.entries[key]
+	return slices.Clone(data), ok, nil
+}
+
+// Save stores the data under the key, replacing any previous data.
+func (store *MemoryStore) Save(key string, data []byte) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.entries[key] = slices.Clone(data)
+	return nil
+}
+
+// FileStore is a Store kept in memory and mirrored to a JSON file on disk,
+// so that arrays saved to it survive between runs of a program.
+// Every save rewrites the file atomically, so it is best suited to small amounts of state.
+type FileStore struct {
+	mu     sync.Mutex
+	path   string
+	memory *MemoryStore
+}
+
+// OpenFileStore opens the store saved in the file at the given path, creating an empty store if the file does not exist.
+//
+// Example:
+//
+//	store, err := persistent.OpenFileStore("state.json")
+//	todos := persistent.New[string](store, "todos")
+//	todos.Push("write docs")
+func OpenFileStore(path string) (*FileStore, error) {
+	store := &FileStore{
+		path:   path,
+		memory: NewMemoryStore(),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	for key, value := range entries {
+		store.memory.Save(key, value)
+	}
+
+	return store, nil
+}
+
+// Load returns the data saved under the key, and whether the key exists.
+func (store *FileStore) Load(key string) ([]byte, bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	return store.memory.Load(key)
+}
+
+// Save stores the data under the key and writes the whole store to its file.
+// If the file cannot be written, the store keeps the data it had before.
+func (store *FileStore) Save(key string, data []byte) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.memory.mu.RLock()
+	entries := make(map[string]json.RawMessage, len(store.memory.entries)+1)
+	for k, value := range store.memory.entries {
+		entries[k] = value
+	}
+	store.memory.mu.RUnlock()
+
+	entries[key] = data
+
+	encoded, err := json.MarshalIndent(entries, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(store.path), filepath.Base(store.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(encoded); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), store.path); err != nil {
+		return err
+	}
+
+	return store.memory.Save(key, data)
+}
+
+// Array is an array kept in a Store under a key.
+// It is loaded lazily on first use, and every mutating method writes the array back to the store
+// before returning, unless auto-flush has been turned off to batch several changes into one write.
+// Transaction applies a group of changes atomically: either all of them are saved, or none are.
+// An Array is safe for concurrent use.
+type Array[T any] struct {
+	mu        sync.Mutex
+	store     Store
+	key       string
+	array     *jsarray.Array[T]
+	dirty     bool
+	autoFlush bool
+}
+
+// New returns an array persisted in the given store under the given key.
+// Nothing is read from the store until the array is first used.
+func New[T any](store Store, key string) *Array[T] {
+	return &Array[T]{
+		store:     store,
+		key:       key,
+		autoFlush: true,
+	}
+}
+
+// Array returns a copy of the current elements of the array, loading them from the store if needed.
+func (array *Array[T]) Array() (*jsarray.Array[T], error) {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	if err := array.load(); err != nil {
+		return nil, err
+	}
+
+	return clone(array.array), nil
+}
+
+// At returns the value at the given index. See Array.At.
+func (array *Array[T]) At(index int) (T, error) {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	if err := array.load(); err != nil {
+		return *new(T), err
+	}
+
+	return array.array.At(index), nil
+}
+
+// Length returns the number of elements in the array.
+func (array *Array[T]) Length() (int, error) {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	if err := array.load(); err != nil {
+		return 0, err
+	}
+
+	return array.array.Length(), nil
+}
+
+// Fill fills the elements of the array from a start index to an end index with a static value,
+// then writes the array through to the store. See Array.Fill.
+func (array *Array[T]) Fill(element T, start int, end ...int) error {
+	return array.mutate(func(arr *jsarray.Array[T]) {
+		arr.Fill(element, start, end...)
+	})
+}
+
+// Pop removes the last element from the array and returns it, then writes the array through to the store.
+// See Array.Pop.
+func (array *Array[T]) Pop() (T, error) {
+	var result T
+
+	err := array.mutate(func(arr *jsarray.Array[T]) {
+		result = arr.Pop()
+	})
+
+	return result, err
+}
+
+// Push adds the given value to the end of the array, then writes the array through to the store.
+// See Array.Push.
+func (array *Array[T]) Push(value T) error {
+	return array.mutate(func(arr *jsarray.Array[T]) {
+		arr.Push(value)
+	})
+}
+
+// Reverse reverses the elements of the array in place, then writes the array through to the store.
+func (array *Array[T]) Reverse() error {
+	return array.mutate(func(arr *jsarray.Array[T]) {
+		arr.Reverse()
+	})
+}
+
+// Shift removes the first element from the array and returns it, then writes the array through to the store.
+// See Array.Shift.
+func (array *Array[T]) Shift() (T, error) {
+	var result T
+
+	err := array.mutate(func(arr *jsarray.Array[T]) {
+		result = arr.Shift()
+	})
+
+	return result, err
+}
+
+// Sort sorts the elements of the array in place, then writes the array through to the store. See Array.Sort.
+func (array *Array[T]) Sort(fn ...func(a, b T) int) error {
+	return array.mutate(func(arr *jsarray.Array[T]) {
+		arr.Sort(fn...)
+	})
+}
+
+// Splice removes, replaces or inserts elements in place and returns the removed elements,
+// then writes the array through to the store. See Array.Splice.
+func (array *Array[T]) Splice(start, deleteCount int, items ...T) (*jsarray.Array[T], error) {
+	var removed *jsarray.Array[T]
+
+	err := array.mutate(func(arr *jsarray.Array[T]) {
+		removed = arr.Splice(start, deleteCount, items...)
+	})
+
+	return removed, err
+}
+
+// Unshift adds one or more elements to the beginning of the array and returns its new length,
+// then writes the array through to the store. See Array.Unshift.
+func (array *Array[T]) Unshift(elements ...T) (int, error) {
+	var length int
+
+	err := array.mutate(func(arr *jsarray.Array[T]) {
+		length = arr.Unshift(elements...)
+	})
+
+	return length, err
+}
+
+// Transaction calls the provided function with a working copy of the array. If the function returns nil,
+// the copy replaces the array and is saved to the store in a single write; if it returns an error, or the
+// save fails, the array is left exactly as it was. The function must not call back into the Array.
+//
+// Example:
+//
+//	err := todos.Transaction(func(arr *jsarray.Array[string]) error {
+//		if arr.Includes(item) {
+//			return errDuplicate
+//		}
+//		arr.Push(item)
+//		return nil
+//	})
+func (array *Array[T]) Transaction(fn func(array *jsarray.Array[T]) error) error {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	if err := array.load(); err != nil {
+		return err
+	}
+
+	working := clone(array.array)
+	if err := fn(working); err != nil {
+		return err
+	}
+
+	if err := array.save(working); err != nil {
+		return err
+	}
+
+	array.array = working
+	array.dirty = false
+
+	return nil
+}
+
+// SetAutoFlush turns writing through to the store after every mutating method on or off.
+// With auto-flush off, changes are only kept in memory until Flush is called, which batches any number
+// of changes into a single write. Turning auto-flush back on flushes pending changes.
+func (array *Array[T]) SetAutoFlush(enabled bool) error {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	array.autoFlush = enabled
+	if enabled {
+		return array.flush()
+	}
+
+	return nil
+}
+
+// Flush writes any changes not yet saved to the store.
+func (array *Array[T]) Flush() error {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	return array.flush()
+}
+
+// mutate loads the array, applies the change and writes it through to the store when auto-flush is on.
+// With auto-flush on, the change is made to a copy that only replaces the array once it has been saved,
+// so that a failed save leaves the array in memory as it is in the store.
+func (array *Array[T]) mutate(fn func(arr *jsarray.Array[T])) error {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	if err := array.load(); err != nil {
+		return err
+	}
+
+	if !array.autoFlush {
+		fn(array.array)
+		array.dirty = true
+
+		return nil
+	}
+
+	working := clone(array.array)
+	fn(working)
+
+	if err := array.save(working); err != nil {
+		return err
+	}
+
+	array.array = working
+	array.dirty = false
+
+	return nil
+}
+
+// load reads the array from the store the first time it is needed.
+// A key that does not exist yet results in an empty array.
+func (array *Array[T]) load() error {
+	if array.array != nil {
+		return nil
+	}
+
+	loaded := jsarray.New[T]()
+
+	data, ok, err := array.store.Load(array.key)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		if err := loaded.UnmarshalJSON(data); err != nil {
+			return err
+		}
+	}
+
+	array.array = loaded
+	return nil
+}
+
+// flush saves the array if it has changes that have not been saved yet.
+func (array *Array[T]) flush() error {
+	if !array.dirty || array.array == nil {
+		return nil
+	}
+
+	if err := array.save(array.array); err != nil {
+		return err
+	}
+
+	array.dirty = false
+	return nil
+}
+
+// save writes the given array to the store under the key of the persistent array.
+func (array *Array[T]) save(arr *jsarray.Array[T]) error {
+	data, err := arr.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return array.store.Save(array.key, data)
+}
+
+// clone returns a copy of the given array that shares no element storage with it,
+// and compares its elements with the same equality function.
+func clone[T any](arr *jsarray.Array[T]) *jsarray.Array[T] {
+	return jsarray.NewWithEntries(arr.Slice(0)).SetEquality(arr.Equality())
+}
@@ -0,0 +1,250 @@
+package persistent
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	jsarray "github.com/iVitaliya/javascript-go/array"
+)
+
+var errSave = errors.New("save failed")
+
+// countingStore is a MemoryStore that counts the calls made to it, and fails to save while failing is set.
+type countingStore struct {
+	*MemoryStore
+	loads, saves int
+	failing      bool
+}
+
+func newCountingStore() *countingStore {
+	return &countingStore{MemoryStore: NewMemoryStore()}
+}
+
+func (store *countingStore) Load(key string) ([]byte, bool, error) {
+	store.loads++
+	return store.MemoryStore.Load(key)
+}
+
+func (store *countingStore) Save(key string, data []byte) error {
+	if store.failing {
+		return errSave
+	}
+
+	store.saves++
+	return store.MemoryStore.Save(key, data)
+}
+
+// saved returns what the store holds under the key.
+func saved(t *testing.T, store Store, key string) string {
+	t.Helper()
+
+	data, _, err := store.Load(key)
+	if err != nil {
+		t.Fatalf("Load(%q) failed: %v", key, err)
+	}
+
+	return string(data)
+}
+
+// elements returns the current elements of the array.
+func elements[T any](t *testing.T, arr *Array[T]) []T {
+	t.Helper()
+
+	current, err := arr.Array()
+	if err != nil {
+		t.Fatalf("Array() failed: %v", err)
+	}
+
+	return current.Slice(0)
+}
+
+func TestWriteThrough(t *testing.T) {
+	store := newCountingStore()
+	arr := New[int](store, "numbers")
+
+	arr.Push(3)
+	arr.Push(1)
+	arr.Push(2)
+	arr.Sort()
+	arr.Splice(1, 1, 9, 8)
+	arr.Fill(0, -1)
+	arr.Pop()
+
+	if got, want := saved(t, store, "numbers"), "[1,9,8]"; got != want {
+		t.Errorf("store holds %s, want %s", got, want)
+	}
+
+	if store.saves != 7 {
+		t.Errorf("saved %d times, want once per mutating call (7)", store.saves)
+	}
+}
+
+func TestLazyLoad(t *testing.T) {
+	store := newCountingStore()
+	store.Save("names", []byte(`["a","b"]`))
+
+	arr := New[string](store, "names")
+	if store.loads != 0 {
+		t.Fatalf("New loaded from the store %d times, want 0", store.loads)
+	}
+
+	if got, _ := arr.Length(); got != 2 {
+		t.Errorf("Length() = %d, want 2", got)
+	}
+
+	arr.At(0)
+	arr.Push("c")
+
+	if store.loads != 1 {
+		t.Errorf("loaded %d times, want 1", store.loads)
+	}
+
+	if got, want := elements(t, arr), []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("Array() = %v, want %v", got, want)
+	}
+}
+
+func TestTransactionRollback(t *testing.T) {
+	store := newCountingStore()
+	arr := New[int](store, "numbers")
+	arr.Push(1)
+
+	errAbort := errors.New("abort")
+	err := arr.Transaction(func(working *jsarray.Array[int]) error {
+		working.Push(2)
+		return errAbort
+	})
+
+	if !errors.Is(err, errAbort) {
+		t.Errorf("Transaction returned %v, want the error of the function", err)
+	}
+
+	store.failing = true
+	err = arr.Transaction(func(working *jsarray.Array[int]) error {
+		working.Push(3)
+		return nil
+	})
+
+	if !errors.Is(err, errSave) {
+		t.Errorf("Transaction returned %v, want the error of the store", err)
+	}
+
+	if got, want := elements(t, arr), []int{1}; !slices.Equal(got, want) {
+		t.Errorf("after rolled back transactions Array() = %v, want %v", got, want)
+	}
+
+	store.failing = false
+	arr.Transaction(func(working *jsarray.Array[int]) error {
+		working.Concat([]int{2, 3})
+		return nil
+	})
+
+	if got, want := saved(t, store, "numbers"), "[1,2,3]"; got != want {
+		t.Errorf("store holds %s, want %s", got, want)
+	}
+}
+
+func TestFailedSaveLeavesArrayUnchanged(t *testing.T) {
+	store := newCountingStore()
+	arr := New[int](store, "numbers")
+	arr.Push(1)
+
+	store.failing = true
+	if err := arr.Push(2); !errors.Is(err, errSave) {
+		t.Errorf("Push returned %v, want the error of the store", err)
+	}
+
+	if got, want := elements(t, arr), []int{1}; !slices.Equal(got, want) {
+		t.Errorf("after a failed save Array() = %v, want %v", got, want)
+	}
+}
+
+func TestAutoFlush(t *testing.T) {
+	store := newCountingStore()
+	arr := New[int](store, "numbers")
+
+	arr.SetAutoFlush(false)
+	arr.Push(1)
+	arr.Push(2)
+	arr.Unshift(0)
+
+	if store.saves != 0 {
+		t.Fatalf("saved %d times with auto-flush off, want 0", store.saves)
+	}
+
+	if err := arr.Flush(); err != nil {
+		t.Fatalf("Flush() failed: %v", err)
+	}
+
+	arr.Flush()
+
+	if store.saves != 1 || saved(t, store, "numbers") != "[0,1,2]" {
+		t.Errorf("after Flush saved %d times holding %s, want once holding [0,1,2]", store.saves, saved(t, store, "numbers"))
+	}
+
+	arr.Shift()
+	if err := arr.SetAutoFlush(true); err != nil {
+		t.Fatalf("SetAutoFlush(true) failed: %v", err)
+	}
+
+	if got, want := saved(t, store, "numbers"), "[1,2]"; got != want {
+		t.Errorf("turning auto-flush on left %s in the store, want %s", got, want)
+	}
+}
+
+func TestTransactionKeepsEquality(t *testing.T) {
+	arr := New[string](NewMemoryStore(), "names")
+
+	arr.Transaction(func(working *jsarray.Array[string]) error {
+		working.SetEquality(strings.EqualFold)
+		working.Push("Ada")
+		return nil
+	})
+
+	arr.Push("Grace")
+
+	current, _ := arr.Array()
+	if !current.Includes("ADA") {
+		t.Error("the equality function set in a transaction was lost")
+	}
+}
+
+func TestFileStoreRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore on a missing file failed: %v", err)
+	}
+
+	New[string](store, "todos").Push("write docs")
+	New[int](store, "counts").Push(42)
+
+	reopened, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenFileStore failed: %v", err)
+	}
+
+	if got, want := elements(t, New[string](reopened, "todos")), []string{"write docs"}; !slices.Equal(got, want) {
+		t.Errorf("todos = %v, want %v", got, want)
+	}
+
+	if got, want := elements(t, New[int](reopened, "counts")), []int{42}; !slices.Equal(got, want) {
+		t.Errorf("counts = %v, want %v", got, want)
+	}
+}
+
+func TestFileStoreKeepsDataWhenWriteFails(t *testing.T) {
+	store, _ := OpenFileStore(filepath.Join(t.TempDir(), "missing", "state.json"))
+
+	if err := store.Save("key", []byte(`[1]`)); err == nil {
+		t.Fatal("Save into a missing directory succeeded")
+	}
+
+	if _, ok, _ := store.Load("key"); ok {
+		t.Error("a failed Save left its data in the store")
+	}
+}
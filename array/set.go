@@ -0,0 +1,319 @@
+package array
+
+import "reflect"
+
+// nanKey is the map key used for NaN, which is never equal to itself and so cannot be a key on its own.
+type nanKey struct{}
+
+// valueSet is a set of elements compared like the elements of an array.
+// With the default SameValueZero equality, values that Go can compare with == are kept in a hash map,
+// so adding and looking them up takes constant time; anything else, including every value when a custom
+// equality function is set, is kept in a list and compared one by one.
+type valueSet[T any] struct {
+	equal  Equality[T]
+	custom bool
+	keyed  comparison
+	keys   map[any]struct{}
+	values []T
+}
+
+// newValueSet returns an empty set comparing its values with the given equality function,
+// or with SameValueZero if it is nil.
+func newValueSet[T any](equal Equality[T]) *valueSet[T] {
+	set := &valueSet[T]{
+		equal:  equal,
+		custom: equal != nil,
+		keyed:  comparisonOf[T](),
+		keys:   map[any]struct{}{},
+	}
+
+	if equal == nil {
+		set.equal = sameValueZero[T]()
+	}
+
+	return set
+}
+
+// add adds the value to the set, and reports whether it was not already present.
+func (set *valueSet[T]) add(value T) bool {
+	if key, ok := set.key(value); ok {
+		if _, found := set.keys[key]; found {
+			return false
+		}
+
+		set.keys[key] = struct{}{}
+		return true
+	}
+
+	if set.hasValue(value) {
+		return false
+	}
+
+	set.values = append(set.values, value)
+	return true
+}
+
+// has reports whether the value is present in the set.
+func (set *valueSet[T]) has(value T) bool {
+	if key, ok := set.key(value); ok {
+		_, found := set.keys[key]
+		return found
+	}
+
+	return set.hasValue(value)
+}
+
+// hasValue reports whether the value is equal to one of the values kept in the list.
+func (set *valueSet[T]) hasValue(value T) bool {
+	for _, v := range set.values {
+		if set.equal(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// key returns the map key for the value, and false if the value has to be compared one by one.
+// Keys agree with SameValueZero: == already treats +0 and -0 as equal, and every NaN shares one key.
+func (set *valueSet[T]) key(value T) (any, bool) {
+	if set.custom {
+		return nil, false
+	}
+
+	switch set.keyed {
+	case compareEqual:
+		return any(value), true
+	case compareFloat:
+		if key := any(value); key == key {
+			return key, true
+		}
+
+		return nanKey{}, true
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+
+	if isNaN(v) {
+		return nanKey{}, true
+	}
+
+	// A value holding a NaN, such as a struct with a NaN field, is not equal to itself as a key,
+	// so it is kept in the list, where SameValueZero compares it.
+	if key := any(value); v.Comparable() && key == key {
+		return key, true
+	}
+
+	return nil, false
+}
+
+// newSet returns a set holding the elements of the array, compared with the equality function of the receiver.
+func (array *Array[T]) newSet(elements []T) *valueSet[T] {
+	set := newValueSet(array.equal)
+	for _, v := range elements {
+		set.add(v)
+	}
+
+	return set
+}
+
+// collect returns a new array holding the first occurrence of every element for which keep returns true.
+func (array *Array[T]) collect(elements []T, seen *valueSet[T], keep func(value T) bool) []T {
+	result := []T{}
+
+	for _, v := range elements {
+		if keep(v) && seen.add(v) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// Difference returns a new array with the elements of the array that are not in the other array,
+// like Set.prototype.difference. Duplicates are removed, keeping the first occurrence of each element.
+//
+// Elements are compared with the equality function of the array. With the default SameValueZero equality,
+// values that Go can compare with == are hashed, so the set methods run in O(n+m) time; other values, and
+// all values when a custom equality function is set, are compared one by one, which takes O(n*m) time.
+// Use UniqueBy to deduplicate by a comparable key in linear time instead.
+//
+// Example:
+//
+//	a := array.NewWithEntries([]int{1, 2, 3, 4})
+//	b := array.NewWithEntries([]int{2, 4})
+//	a.Difference(b) // [1, 3]
+func (array *Array[T]) Difference(other *Array[T]) *Array[T] {
+	if array.tracing() {
+		defer array.traceCall("Difference", other)()
+	}
+
+	exclude := array.newSet(other.array)
+
+	return array.derive(array.collect(array.array, newValueSet(array.equal), func(value T) bool {
+		return !exclude.has(value)
+	}))
+}
+
+// Intersection returns a new array with the elements of the array that are also in the other array,
+// like Set.prototype.intersection. Duplicates are removed, and the elements keep the order of the array.
+// See Difference for how elements are compared.
+func (array *Array[T]) Intersection(other *Array[T]) *Array[T] {
+	if array.tracing() {
+		defer array.traceCall("Intersection", other)()
+	}
+
+	include := array.newSet(other.array)
+
+	return array.derive(array.collect(array.array, newValueSet(array.equal), include.has))
+}
+
+// IsDisjointFrom reports whether the array has no elements in common with the other array,
+// like Set.prototype.isDisjointFrom. See Difference for how elements are compared.
+func (array *Array[T]) IsDisjointFrom(other *Array[T]) bool {
+	if array.tracing() {
+		defer array.traceCall("IsDisjointFrom", other)()
+	}
+
+	set := array.newSet(array.array)
+
+	for _, v := range other.array {
+		if set.has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSubsetOf reports whether every element of the array is also in the other array,
+// like Set.prototype.isSubsetOf. See Difference for how elements are compared.
+func (array *Array[T]) IsSubsetOf(other *Array[T]) bool {
+	if array.tracing() {
+		defer array.traceCall("IsSubsetOf", other)()
+	}
+
+	set := array.newSet(other.array)
+
+	for _, v := range array.array {
+		if !set.has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf reports whether every element of the other array is also in the array,
+// like Set.prototype.isSupersetOf. See Difference for how elements are compared.
+func (array *Array[T]) IsSupersetOf(other *Array[T]) bool {
+	if array.tracing() {
+		defer array.traceCall("IsSupersetOf", other)()
+	}
+
+	set := array.newSet(array.array)
+
+	for _, v := range other.array {
+		if !set.has(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SymmetricDifference returns a new array with the elements that are in exactly one of the array and the
+// other array, like Set.prototype.symmetricDifference: first those of the array, then those of the other
+// array, each in their original order. Duplicates are removed. See Difference for how elements are compared.
+func (array *Array[T]) SymmetricDifference(other *Array[T]) *Array[T] {
+	if array.tracing() {
+		defer array.traceCall("SymmetricDifference", other)()
+	}
+
+	var (
+		mine   = array.newSet(array.array)
+		theirs = array.newSet(other.array)
+		seen   = newValueSet(array.equal)
+		result = array.collect(array.array, seen, func(value T) bool {
+			return !theirs.has(value)
+		})
+	)
+
+	result = append(result, array.collect(other.array, seen, func(value T) bool {
+		return !mine.has(value)
+	})...)
+
+	return array.derive(result)
+}
+
+// Union returns a new array with the elements of the array followed by the elements of the other array
+// that it does not already hold, like Set.prototype.union. Duplicates are removed, keeping the first
+// occurrence of each element. See Difference for how elements are compared.
+func (array *Array[T]) Union(other *Array[T]) *Array[T] {
+	if array.tracing() {
+		defer array.traceCall("Union", other)()
+	}
+
+	var (
+		seen   = newValueSet(array.equal)
+		keep   = func(T) bool { return true }
+		result = array.collect(array.array, seen, keep)
+	)
+
+	result = append(result, array.collect(other.array, seen, keep)...)
+
+	return array.derive(result)
+}
+
+// Unique returns a new array with the duplicate elements of the array removed, keeping the first occurrence
+// of each element. See Difference for how elements are compared.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{3, 1, 3, 2, 1})
+//	arr.Unique() // [3, 1, 2]
+func (array *Array[T]) Unique() *Array[T] {
+	if array.tracing() {
+		defer array.traceCall("Unique")()
+	}
+
+	return array.derive(array.collect(array.array, newValueSet(array.equal), func(T) bool {
+		return true
+	}))
+}
+
+// derive returns a new array holding the given elements and using the equality function of the array.
+func (array *Array[T]) derive(elements []T) *Array[T] {
+	return &Array[T]{
+		array: elements,
+		equal: array.equal,
+	}
+}
+
+// UniqueBy returns a new array with the elements of the given array whose key, as returned by the provided
+// function, has not been seen before, keeping the first element for each key. Keys are hashed, so UniqueBy
+// takes linear time whatever the element type. The callback function takes the element value and its index.
+//
+// Example:
+//
+//	users := array.UniqueBy(arr, func(user User, _ int) string {
+//		return user.Email
+//	})
+func UniqueBy[T any, K comparable](array *Array[T], fn func(value T, index int) K) *Array[T] {
+	var (
+		seen   = map[K]struct{}{}
+		result = []T{}
+	)
+
+	array.ForEach(func(value T, index int, _ *Array[T]) {
+		key := fn(value, index)
+		if _, ok := seen[key]; ok {
+			return
+		}
+
+		seen[key] = struct{}{}
+		result = append(result, value)
+	})
+
+	return array.derive(result)
+}
@@ -0,0 +1,161 @@
+package array
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+var negativeZero = math.Copysign(0, -1)
+
+func TestSetSameValueZero(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		name string
+		got  func() any
+		want any
+	}{
+		{"Unique keeps one NaN", func() any { return NewWithEntries([]float64{nan, 1, nan}).Unique().array }, "[NaN 1]"},
+		{"Unique merges 0 and -0", func() any {
+			unique := NewWithEntries([]float64{0, negativeZero, 1}).Unique().array
+			return fmt.Sprint(unique, math.Signbit(unique[0]))
+		}, "[0 1] false"},
+		{"Unique keeps the first of -0 and 0", func() any {
+			return math.Signbit(NewWithEntries([]float64{negativeZero, 0}).Unique().array[0])
+		}, true},
+		{"Unique of NaN inside any", func() any { return NewWithEntries([]any{nan, "a", nan, 1}).Unique().array }, "[NaN a 1]"},
+		{"Unique of float32 NaN", func() any {
+			n := float32(nan)
+			return NewWithEntries([]float32{n, n}).Unique().Length()
+		}, 1},
+		{"Intersection keeps NaN", func() any {
+			return NewWithEntries([]float64{1, nan}).Intersection(NewWithEntries([]float64{nan})).array
+		}, "[NaN]"},
+		{"Difference removes NaN", func() any {
+			return NewWithEntries([]float64{nan, 2}).Difference(NewWithEntries([]float64{nan})).array
+		}, "[2]"},
+		{"-0 is a subset of 0", func() any { return NewWithEntries([]float64{negativeZero}).IsSubsetOf(NewWithEntries([]float64{0})) }, true},
+		{"NaN is not disjoint from NaN", func() any {
+			return NewWithEntries([]float64{nan}).IsDisjointFrom(NewWithEntries([]float64{nan}))
+		}, false},
+		{"uncomparable values", func() any {
+			return NewWithEntries([]any{[]int{1}, []int{1}, map[string]int{}, []int{2}}).Unique().Length()
+		}, 3},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprint(tt.got()); got != fmt.Sprint(tt.want) {
+			t.Errorf("%s: got %s, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSetOrder(t *testing.T) {
+	a := NewWithEntries([]int{3, 1, 3, 2})
+	b := NewWithEntries([]int{4, 2, 5, 4, 1})
+
+	tests := []struct {
+		name string
+		got  *Array[int]
+		want string
+	}{
+		{"Union", a.Union(b), "[3 1 2 4 5]"},
+		{"Union the other way", b.Union(a), "[4 2 5 1 3]"},
+		{"Intersection", a.Intersection(b), "[1 2]"},
+		{"Difference", a.Difference(b), "[3]"},
+		{"SymmetricDifference", a.SymmetricDifference(b), "[3 4 5]"},
+		{"SymmetricDifference the other way", b.SymmetricDifference(a), "[4 5 3]"},
+		{"Unique", a.Unique(), "[3 1 2]"},
+		{"Union with empty", New[int]().Union(a), "[3 1 2]"},
+		{"Intersection with empty", a.Intersection(New[int]()), "[]"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprint(tt.got.array); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	if !a.IsSubsetOf(a.Union(b)) || !b.Union(a).IsSupersetOf(a) || a.IsSubsetOf(b) || a.IsDisjointFrom(b) {
+		t.Error("the subset, superset and disjoint checks disagree with Union")
+	}
+}
+
+func TestSetCustomEquality(t *testing.T) {
+	a := NewWithEntries([]string{"Go", "js", "GO", "Rust"}).SetEquality(strings.EqualFold)
+	b := NewWithEntries([]string{"JS", "zig", "rust"})
+
+	tests := []struct {
+		name string
+		got  *Array[string]
+		want string
+	}{
+		{"Unique", a.Unique(), "[Go js Rust]"},
+		{"Union", a.Union(b), "[Go js Rust zig]"},
+		{"Intersection", a.Intersection(b), "[js Rust]"},
+		{"Difference", a.Difference(b), "[Go]"},
+		{"SymmetricDifference", a.SymmetricDifference(b), "[Go zig]"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprint(tt.got.array); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+		}
+
+		if !tt.got.Includes("ZIG") && strings.Contains(tt.want, "zig") {
+			t.Errorf("%s lost the equality function", tt.name)
+		}
+	}
+
+	// A custom equality cannot be hashed, so every value goes to the list, even if Go can compare it.
+	set := a.newSet(a.array)
+	if len(set.keys) != 0 || len(set.values) != 3 {
+		t.Errorf("a set with a custom equality hashed %d values and listed %d, want 0 and 3", len(set.keys), len(set.values))
+	}
+
+	set = New[string]().newSet([]string{"a", "b", "a"})
+	if len(set.keys) != 2 || len(set.values) != 0 {
+		t.Errorf("a set of strings hashed %d values and listed %d, want 2 and 0", len(set.keys), len(set.values))
+	}
+
+	mixed := New[any]().newSet([]any{1, []int{1}, []int{1}})
+	if len(mixed.keys) != 1 || len(mixed.values) != 1 {
+		t.Errorf("a set of mixed values hashed %d values and listed %d, want 1 and 1", len(mixed.keys), len(mixed.values))
+	}
+}
+
+func TestUniqueBy(t *testing.T) {
+	type user struct {
+		Name, Email string
+	}
+
+	users := NewWithEntries([]user{
+		{"Ada", "ada@example.com"},
+		{"Bob", "bob@example.com"},
+		{"Ada L.", "ada@example.com"},
+	})
+
+	var indices []int
+	got := UniqueBy(users, func(value user, index int) string {
+		indices = append(indices, index)
+		return value.Email
+	})
+
+	if fmt.Sprint(got.array, indices) != "[{Ada ada@example.com} {Bob bob@example.com}] [0 1 2]" {
+		t.Errorf("UniqueBy() = %v with indices %v, want the first user per email", got.array, indices)
+	}
+
+	byLength := UniqueBy(NewWithEntries([]string{"a", "bb", "c", "dd", "eee"}), func(value string, index int) int {
+		return len(value)
+	})
+
+	if fmt.Sprint(byLength.array) != "[a bb eee]" {
+		t.Errorf("UniqueBy(len) = %v, want [a bb eee]", byLength.array)
+	}
+
+	if got := UniqueBy(New[int](), func(value, index int) int { return value }); got.Length() != 0 {
+		t.Errorf("UniqueBy of an empty array has length %d", got.Length())
+	}
+}
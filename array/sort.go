@@ -0,0 +1,223 @@
+package array
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// sortStable sorts the slice in place with the optional comparison function, following the SortCompare
+// steps of Array.prototype.sort: undefined values (nil interfaces and pointers) go last, and without a
+// comparison function the remaining values are ordered by the UTF-16 code units of their string form.
+func sortStable[T any](arr []T, fn []func(a, b T) int) {
+	var (
+		defined = make([]T, 0, len(arr))
+		missing = 0
+	)
+
+	for _, v := range arr {
+		if isUndefined(v) {
+			missing++
+			continue
+		}
+
+		defined = append(defined, v)
+	}
+
+	if len(fn) > 0 && fn[0] != nil {
+		slices.SortStableFunc(defined, fn[0])
+	} else {
+		defined = sortByString(defined)
+	}
+
+	copy(arr, defined)
+
+	var zero T
+	for i := len(defined); i < len(arr); i++ {
+		arr[i] = zero
+	}
+}
+
+// sortCompare returns the comparison used to order two values, following the SortCompare steps of
+// Array.prototype.sort: undefined values (nil interfaces and pointers) go last without being passed to the
+// optional comparison function, and without one the other values are ordered by the UTF-16 code units of
+// their string form. Unlike sortStable, it converts values to strings on every comparison.
+func sortCompare[T any](fn []func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		switch undefinedA, undefinedB := isUndefined(a), isUndefined(b); {
+		case undefinedA && undefinedB:
+			return 0
+		case undefinedA:
+			return 1
+		case undefinedB:
+			return -1
+		}
+
+		if len(fn) > 0 && fn[0] != nil {
+			return fn[0](a, b)
+		}
+
+		return slices.Compare(utf16.Encode([]rune(toJSString(a))), utf16.Encode([]rune(toJSString(b))))
+	}
+}
+
+// sortByString stably sorts the values by the UTF-16 code units of their JavaScript string form.
+// Each value is converted once up front rather than on every comparison.
+func sortByString[T any](values []T) []T {
+	type keyed struct {
+		key   []uint16
+		value T
+	}
+
+	items := make([]keyed, len(values))
+	for i, v := range values {
+		items[i] = keyed{
+			key:   utf16.Encode([]rune(toJSString(v))),
+			value: v,
+		}
+	}
+
+	slices.SortStableFunc(items, func(a, b keyed) int {
+		return slices.Compare(a.key, b.key)
+	})
+
+	for i, item := range items {
+		values[i] = item.value
+	}
+
+	return values
+}
+
+// isUndefined reports whether the value is the closest Go has to undefined: a nil interface or nil pointer.
+func isUndefined[T any](value T) bool {
+	v := reflect.ValueOf(&value).Elem()
+
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+
+	return false
+}
+
+// toJSString converts a value to a string the way the ToString operation of JavaScript would convert
+// the corresponding JavaScript value: numbers use the JavaScript number formatting, slices and arrays
+// are joined with commas, nil becomes "undefined", and anything else falls back to fmt.Sprint.
+func toJSString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "undefined"
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case fmt.Stringer:
+		return v.String()
+	case error:
+		return v.Error()
+	case float64:
+		return formatJSNumber(v)
+	case float32:
+		return formatJSNumber(float64(v))
+	}
+
+	rv := reflect.ValueOf(value)
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return formatJSNumber(rv.Float())
+	case reflect.String:
+		return rv.String()
+	case reflect.Slice, reflect.Array:
+		parts := make([]string, rv.Len())
+		for i := range parts {
+			elem := rv.Index(i)
+			if (elem.Kind() == reflect.Interface || elem.Kind() == reflect.Pointer) && elem.IsNil() {
+				continue
+			}
+
+			parts[i] = toJSString(elem.Interface())
+		}
+
+		return strings.Join(parts, ",")
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return "undefined"
+		}
+	}
+
+	return fmt.Sprint(value)
+}
+
+// formatJSNumber formats a float64 the way Number.prototype.toString does: the shortest round-tripping
+// digits, in plain decimal notation for magnitudes in [1e-6, 1e21) and in exponent notation otherwise.
+func formatJSNumber(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case f == 0:
+		return "0"
+	}
+
+	sign := ""
+	if f < 0 {
+		sign = "-"
+		f = -f
+	}
+
+	// Split the shortest representation d.ddde±x into its digits and the decimal exponent n,
+	// where the value is 0.digits * 10^n as in the Number::toString algorithm.
+	var (
+		repr      = strconv.FormatFloat(f, 'e', -1, 64)
+		mantissa  = repr[:strings.IndexByte(repr, 'e')]
+		digits    = strings.Replace(mantissa, ".", "", 1)
+		exp, _    = strconv.Atoi(repr[strings.IndexByte(repr, 'e')+1:])
+		n         = exp + 1
+		k         = len(digits)
+		formatted string
+	)
+
+	switch {
+	case k <= n && n <= 21:
+		formatted = digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= 21:
+		formatted = digits[:n] + "." + digits[n:]
+	case -6 < n && n <= 0:
+		formatted = "0." + strings.Repeat("0", -n) + digits
+	default:
+		expSign := "+"
+		if n-1 < 0 {
+			expSign = "-"
+		}
+
+		formatted = digits[:1]
+		if k > 1 {
+			formatted += "." + digits[1:]
+		}
+
+		formatted += "e" + expSign + strconv.Itoa(abs(n-1))
+	}
+
+	return sign + formatted
+}
+
+// abs returns the absolute value of an int.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
@@ -0,0 +1,131 @@
+package array
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// These cases follow the test262 tests of Array.prototype.sort: the default comparator orders values by
+// the UTF-16 code units of their string form, undefined goes last, and the sort is stable.
+
+func TestSortDefaultComparatorNumbers(t *testing.T) {
+	tests := []struct {
+		input []float64
+		want  []float64
+	}{
+		{[]float64{1, 10, 2, 21}, []float64{1, 10, 2, 21}},
+		{[]float64{10, 9, 1e21, -1, 0.5}, []float64{-1, 0.5, 10, 1e21, 9}},
+		{[]float64{3, 20, 100}, []float64{100, 20, 3}},
+		{[]float64{1e-7, 0.000001, 2}, []float64{0.000001, 1e-7, 2}},
+	}
+
+	for _, tt := range tests {
+		if got := NewWithEntries(tt.input).ToSorted(); !slices.Equal(got, tt.want) {
+			t.Errorf("ToSorted(%v) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSortDefaultComparatorSpecialNumbers(t *testing.T) {
+	var (
+		negZero = math.Copysign(0, -1)
+		input   = []float64{math.NaN(), 1, negZero, 0, math.Inf(1), math.Inf(-1)}
+		got     = NewWithEntries(input).ToSorted()
+	)
+
+	// "-Infinity" < "0" == "0" < "1" < "Infinity" < "NaN", with -0 and 0 kept in their original order.
+	if !math.IsInf(got[0], -1) || !math.Signbit(got[1]) || got[2] != 0 || math.Signbit(got[2]) ||
+		got[3] != 1 || !math.IsInf(got[4], 1) || !math.IsNaN(got[5]) {
+		t.Errorf("ToSorted(%v) = %v, want [-Inf -0 0 1 +Inf NaN]", input, got)
+	}
+}
+
+func TestSortDefaultComparatorMixed(t *testing.T) {
+	input := []any{true, 10, "9", nil, 2.5, "a", nil, false, -3}
+	want := []any{-3, 10, 2.5, "9", "a", false, true, nil, nil}
+
+	if got := NewWithEntries(input).ToSorted(); !slices.Equal(got, want) {
+		t.Errorf("ToSorted(%v) = %v, want %v", input, got, want)
+	}
+}
+
+func TestSortDefaultComparatorUTF16(t *testing.T) {
+	tests := []struct {
+		input []string
+		want  []string
+	}{
+		{[]string{"b", "a", "B", "A"}, []string{"A", "B", "a", "b"}},
+		// U+1F600 is encoded as the surrogate pair D83D DE00, which sorts before U+FF61,
+		// although its code point is greater.
+		{[]string{"\uFF61", "\U0001F600"}, []string{"\U0001F600", "\uFF61"}},
+		{[]string{"\uFFFF", "\U00010000", "\uE000"}, []string{"\U00010000", "\uE000", "\uFFFF"}},
+		{[]string{"ab", "a", "", "abc"}, []string{"", "a", "ab", "abc"}},
+	}
+
+	for _, tt := range tests {
+		arr := NewWithEntries(tt.input)
+		arr.Sort()
+
+		if !slices.Equal(arr.array, tt.want) {
+			t.Errorf("Sort(%q) = %q, want %q", tt.input, arr.array, tt.want)
+		}
+	}
+}
+
+func TestSortStability(t *testing.T) {
+	type entry struct {
+		key, order int
+	}
+
+	// Like test262's stability-2048-elements, with many runs of equal keys.
+	input := make([]entry, 2048)
+	for i := range input {
+		input[i] = entry{key: (i * 7) % 5, order: i}
+	}
+
+	check := func(name string, got []entry) {
+		t.Helper()
+
+		for i := 1; i < len(got); i++ {
+			if got[i-1].key > got[i].key || (got[i-1].key == got[i].key && got[i-1].order > got[i].order) {
+				t.Fatalf("%s is not stable at %d: %v then %v", name, i, got[i-1], got[i])
+			}
+		}
+	}
+
+	byKey := func(a, b entry) int { return a.key - b.key }
+
+	check("ToSorted", NewWithEntries(input).ToSorted(byKey))
+
+	arr := NewWithEntries(input)
+	arr.Sort(byKey)
+	check("Sort", arr.array)
+}
+
+func TestSortDefaultComparatorStability(t *testing.T) {
+	// 1, "1" and 1.0 all convert to the string "1", so they compare equal and keep their order.
+	input := []any{"1", 2, 1, "0", 1.0, float32(1)}
+	want := []any{"0", "1", 1, 1.0, float32(1), 2}
+
+	if got := NewWithEntries(input).ToSorted(); !slices.Equal(got, want) {
+		t.Errorf("ToSorted(%v) = %v, want %v", input, got, want)
+	}
+}
+
+func TestSortComparatorSkipsUndefined(t *testing.T) {
+	a, b := 2, 1
+	input := []*int{nil, &a, nil, &b}
+
+	got := NewWithEntries(input).ToSorted(func(x, y *int) int {
+		if x == nil || y == nil {
+			t.Fatal("comparator called with undefined")
+		}
+
+		return *x - *y
+	})
+
+	if got[0] != &b || got[1] != &a || got[2] != nil || got[3] != nil {
+		t.Errorf("ToSorted = %v, want [&1 &2 nil nil]", got)
+	}
+}
@@ -0,0 +1,260 @@
+package array
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/iVitaliya/javascript-go/internal/bounds"
+)
+
+// SortedArray is an array that keeps its elements in sorted order, so that they can be looked up with a
+// binary search in O(log n) time instead of the linear scan of Array.IndexOf.
+// The order is given by a comparison function following the conventions of Array.Sort: it returns a negative
+// number if a sorts before b, a positive number if a sorts after b, and zero if their order does not matter;
+// nil interfaces and pointers always sort last; and without a comparison function, elements are ordered by
+// their JavaScript string form, so numbers sort as strings ([1, 10, 9]). Pass cmp.Compare to sort numbers
+// numerically. Elements that compare equal keep the order in which they were inserted.
+//
+// Example:
+//
+//	arr := array.NewSorted(cmp.Compare[int])
+//	arr.Insert(5)
+//	arr.Insert(1)
+//	arr.Insert(3)
+//	// arr is now [1, 3, 5]
+//	arr.LowerBound(2) // 1
+type SortedArray[T any] struct {
+	array   []T
+	compare func(a, b T) int
+}
+
+// NewSorted returns a new empty sorted array ordered by the optional comparison function.
+func NewSorted[T any](fn ...func(a, b T) int) *SortedArray[T] {
+	return &SortedArray[T]{
+		array:   []T{},
+		compare: sortCompare(fn),
+	}
+}
+
+// NewSortedWithEntries creates a new sorted array holding a sorted copy of the given entries,
+// ordered by the optional comparison function.
+func NewSortedWithEntries[T any](entries []T, fn ...func(a, b T) int) *SortedArray[T] {
+	array := &SortedArray[T]{
+		array:   cloneSlice(entries),
+		compare: sortCompare(fn),
+	}
+
+	slices.SortStableFunc(array.array, array.compare)
+
+	return array
+}
+
+// At returns the value at the given index.
+// Negative indices count back from the end of the array, so At(0) is the smallest element and At(-1) the largest.
+// If the index is out of range, it returns a zero value of type T.
+func (array *SortedArray[T]) At(index int) T {
+	k, ok := bounds.Relative(index, len(array.array))
+	if !ok {
+		return *new(T)
+	}
+
+	return array.array[k]
+}
+
+// BinarySearch searches for the value and returns the index of the first element comparing equal to it,
+// and true; or, if there is no such element, the index at which it would be inserted, and false.
+func (array *SortedArray[T]) BinarySearch(value T) (int, bool) {
+	return slices.BinarySearchFunc(array.array, value, array.compare)
+}
+
+// Entries returns an iterator over the index/value pairs of the array, in sorted order.
+func (array *SortedArray[T]) Entries() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(array.array); i++ {
+			if !yield(i, array.array[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Includes reports whether the array holds an element comparing equal to the value.
+func (array *SortedArray[T]) Includes(value T) bool {
+	_, found := array.BinarySearch(value)
+
+	return found
+}
+
+// IndexOf returns the index of the first element comparing equal to the value, or -1 if there is none.
+// Unlike Array.IndexOf, elements are compared with the comparison function of the array.
+func (array *SortedArray[T]) IndexOf(value T) int {
+	index, found := array.BinarySearch(value)
+	if !found {
+		return -1
+	}
+
+	return index
+}
+
+// Insert inserts the value at its place in the sorted order and returns the index it was inserted at.
+// A value comparing equal to existing elements is inserted after them.
+func (array *SortedArray[T]) Insert(value T) int {
+	index := array.UpperBound(value)
+	array.array = slices.Insert(array.array, index, value)
+
+	return index
+}
+
+// Join joins all elements of the array into a string, separated by the given separator. See Array.Join.
+func (array *SortedArray[T]) Join(separator string) string {
+	return array.ToArray().Join(separator)
+}
+
+// Length returns the number of elements in the array.
+func (array *SortedArray[T]) Length() int {
+	return len(array.array)
+}
+
+// LowerBound returns the index of the first element that does not sort before the value,
+// or the length of the array if every element does.
+func (array *SortedArray[T]) LowerBound(value T) int {
+	index, _ := array.BinarySearch(value)
+
+	return index
+}
+
+// Merge returns a new sorted array holding the elements of both arrays, ordered by the comparison function
+// of this array. It takes linear time when both arrays use the same order; if the other array is ordered
+// differently, its elements are sorted first. Elements comparing equal keep the elements of this array first.
+func (array *SortedArray[T]) Merge(other *SortedArray[T]) *SortedArray[T] {
+	theirs := other.array
+	if !slices.IsSortedFunc(theirs, array.compare) {
+		theirs = cloneSlice(theirs)
+		slices.SortStableFunc(theirs, array.compare)
+	}
+
+	var (
+		mine   = array.array
+		result = make([]T, 0, len(mine)+len(theirs))
+	)
+
+	for len(mine) > 0 && len(theirs) > 0 {
+		if array.compare(theirs[0], mine[0]) < 0 {
+			result = append(result, theirs[0])
+			theirs = theirs[1:]
+		} else {
+			result = append(result, mine[0])
+			mine = mine[1:]
+		}
+	}
+
+	result = append(result, mine...)
+	result = append(result, theirs...)
+
+	return &SortedArray[T]{
+		array:   result,
+		compare: array.compare,
+	}
+}
+
+// Pop removes the largest element from the array and returns it.
+// If the array is empty, it returns a zero value of type T.
+func (array *SortedArray[T]) Pop() T {
+	if len(array.array) == 0 {
+		return *new(T)
+	}
+
+	result := array.array[len(array.array)-1]
+	array.array = array.array[:len(array.array)-1]
+
+	return result
+}
+
+// Range returns a copy of the elements that sort between low, inclusive, and high, exclusive.
+//
+// Example:
+//
+//	arr := array.NewSortedWithEntries([]int{1, 3, 5, 7}, cmp.Compare[int])
+//	arr.Range(3, 7) // [3, 5]
+func (array *SortedArray[T]) Range(low, high T) []T {
+	from, to := array.LowerBound(low), array.LowerBound(high)
+	if to < from {
+		return []T{}
+	}
+
+	return cloneSlice(array.array[from:to])
+}
+
+// Remove removes the first element comparing equal to the value, and reports whether there was one.
+func (array *SortedArray[T]) Remove(value T) bool {
+	index, found := array.BinarySearch(value)
+	if found {
+		array.array = slices.Delete(array.array, index, index+1)
+	}
+
+	return found
+}
+
+// RemoveAt removes the element at the given index and returns it.
+// Negative indices count back from the end of the array.
+// If the index is out of range, it returns a zero value of type T and false.
+func (array *SortedArray[T]) RemoveAt(index int) (T, bool) {
+	k, ok := bounds.Relative(index, len(array.array))
+	if !ok {
+		return *new(T), false
+	}
+
+	result := array.array[k]
+	array.array = slices.Delete(array.array, k, k+1)
+
+	return result, true
+}
+
+// Shift removes the smallest element from the array and returns it.
+// If the array is empty, it returns a zero value of type T.
+func (array *SortedArray[T]) Shift() T {
+	result, _ := array.RemoveAt(0)
+
+	return result
+}
+
+// ToArray returns a new Array holding the elements of the sorted array, in sorted order.
+func (array *SortedArray[T]) ToArray() *Array[T] {
+	return &Array[T]{
+		array: cloneSlice(array.array),
+	}
+}
+
+// ToString returns a string representation of the array. See Array.ToString.
+func (array *SortedArray[T]) ToString() string {
+	return array.ToArray().ToString()
+}
+
+// UpperBound returns the index of the first element that sorts after the value,
+// or the length of the array if none does.
+func (array *SortedArray[T]) UpperBound(value T) int {
+	low, high := 0, len(array.array)
+
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+
+		if array.compare(array.array[mid], value) <= 0 {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+
+	return low
+}
+
+// Values returns an iterator over the elements of the array, in sorted order.
+func (array *SortedArray[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < len(array.array); i++ {
+			if !yield(array.array[i]) {
+				return
+			}
+		}
+	}
+}
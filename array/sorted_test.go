@@ -0,0 +1,201 @@
+package array
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// ranked is an element whose tag is not compared, to check that equal elements keep their insertion order.
+type ranked struct {
+	key int
+	tag string
+}
+
+func byKey(a, b ranked) int { return cmp.Compare(a.key, b.key) }
+
+func TestSortedSearch(t *testing.T) {
+	arr := NewSortedWithEntries([]int{7, 1, 5, 3, 5, 9}, cmp.Compare[int])
+
+	tests := []struct {
+		value        int
+		index        int
+		found        bool
+		lower, upper int
+	}{
+		{0, 0, false, 0, 0},
+		{1, 0, true, 0, 1},
+		{4, 2, false, 2, 2},
+		{5, 2, true, 2, 4},
+		{6, 4, false, 4, 4},
+		{9, 5, true, 5, 6},
+		{10, 6, false, 6, 6},
+	}
+
+	for _, tt := range tests {
+		index, found := arr.BinarySearch(tt.value)
+		if index != tt.index || found != tt.found {
+			t.Errorf("BinarySearch(%d) = %d, %v, want %d, %v", tt.value, index, found, tt.index, tt.found)
+		}
+
+		if got := arr.LowerBound(tt.value); got != tt.lower {
+			t.Errorf("LowerBound(%d) = %d, want %d", tt.value, got, tt.lower)
+		}
+
+		if got := arr.UpperBound(tt.value); got != tt.upper {
+			t.Errorf("UpperBound(%d) = %d, want %d", tt.value, got, tt.upper)
+		}
+
+		want := -1
+		if tt.found {
+			want = tt.index
+		}
+
+		if got := arr.IndexOf(tt.value); got != want || arr.Includes(tt.value) != tt.found {
+			t.Errorf("IndexOf(%d) = %d, Includes = %v, want %d, %v", tt.value, got, arr.Includes(tt.value), want, tt.found)
+		}
+	}
+
+	empty := NewSorted(cmp.Compare[int])
+	if index, found := empty.BinarySearch(1); index != 0 || found || empty.LowerBound(1) != 0 || empty.UpperBound(1) != 0 {
+		t.Error("searching an empty array did not return 0")
+	}
+}
+
+func TestSortedDefaultOrder(t *testing.T) {
+	// Without a comparison function, numbers sort by their string form, like Array.prototype.sort.
+	arr := NewSortedWithEntries([]int{9, 10, 1, 100})
+
+	if got := arr.Join(","); got != "1,10,100,9" {
+		t.Errorf("default order = %s, want 1,10,100,9", got)
+	}
+
+	if index := arr.Insert(2); index != 3 || arr.Join(",") != "1,10,100,2,9" {
+		t.Errorf("Insert(2) = %d leaving %s, want 3 leaving 1,10,100,2,9", index, arr.Join(","))
+	}
+}
+
+func TestSortedInsertStable(t *testing.T) {
+	arr := NewSortedWithEntries([]ranked{{2, "a"}, {1, "b"}, {2, "c"}}, byKey)
+
+	if index := arr.Insert(ranked{2, "d"}); index != 3 {
+		t.Errorf("Insert of an equal element = %d, want 3, after the others", index)
+	}
+
+	arr.Insert(ranked{1, "e"})
+	arr.Insert(ranked{0, "f"})
+
+	tags := ""
+	for _, v := range arr.Entries() {
+		tags += v.tag
+	}
+
+	if tags != "fbeacd" {
+		t.Errorf("Insert order = %s, want fbeacd", tags)
+	}
+
+	if index := arr.IndexOf(ranked{2, "x"}); index != 3 || arr.At(index).tag != "a" {
+		t.Errorf("IndexOf(2) = %d, want the first equal element at 3", index)
+	}
+}
+
+func TestSortedRange(t *testing.T) {
+	arr := NewSortedWithEntries([]int{1, 3, 5, 5, 7}, cmp.Compare[int])
+
+	tests := []struct {
+		low, high int
+		want      string
+	}{
+		{3, 7, "[3 5 5]"},
+		{2, 6, "[3 5 5]"},
+		{5, 5, "[]"},
+		{5, 6, "[5 5]"},
+		{0, 100, "[1 3 5 5 7]"},
+		{7, 3, "[]"},
+		{8, 9, "[]"},
+	}
+
+	for _, tt := range tests {
+		got := arr.Range(tt.low, tt.high)
+		if fmt.Sprint(got) != tt.want {
+			t.Errorf("Range(%d, %d) = %v, want %s", tt.low, tt.high, got, tt.want)
+		}
+
+		if len(got) > 0 {
+			got[0] = -1
+			if arr.At(0) == -1 || arr.Includes(-1) {
+				t.Fatal("changing the result of Range changed the array")
+			}
+		}
+	}
+}
+
+func TestSortedMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		bCmp func(a, b int) int
+		want string
+	}{
+		{"interleaved", []int{1, 4, 6}, []int{2, 3, 7}, cmp.Compare[int], "[1 2 3 4 6 7]"},
+		{"one empty", []int{1, 2}, nil, cmp.Compare[int], "[1 2]"},
+		{"other empty", nil, []int{1, 2}, cmp.Compare[int], "[1 2]"},
+		{"other reversed", []int{1, 5}, []int{2, 4, 6}, func(a, b int) int { return b - a }, "[1 2 4 5 6]"},
+		{"duplicates", []int{1, 2}, []int{1, 2}, cmp.Compare[int], "[1 1 2 2]"},
+	}
+
+	for _, tt := range tests {
+		a := NewSortedWithEntries(tt.a, cmp.Compare[int])
+		b := NewSortedWithEntries(tt.b, tt.bCmp)
+
+		if got := fmt.Sprint(a.Merge(b).array); got != tt.want {
+			t.Errorf("%s: Merge() = %s, want %s", tt.name, got, tt.want)
+		}
+
+		if len(a.array) != len(tt.a) || len(b.array) != len(tt.b) {
+			t.Errorf("%s: Merge changed its operands", tt.name)
+		}
+	}
+
+	a := NewSortedWithEntries([]ranked{{1, "a1"}, {2, "a2"}}, byKey)
+	b := NewSortedWithEntries([]ranked{{1, "b1"}, {2, "b2"}}, byKey)
+
+	tags := []string{}
+	for v := range a.Merge(b).Values() {
+		tags = append(tags, v.tag)
+	}
+
+	if !slices.Equal(tags, []string{"a1", "b1", "a2", "b2"}) {
+		t.Errorf("Merge of equal elements = %v, want those of the receiver first", tags)
+	}
+}
+
+func TestSortedRemove(t *testing.T) {
+	arr := NewSortedWithEntries([]int{1, 2, 2, 3, 4}, cmp.Compare[int])
+
+	if !arr.Remove(2) || arr.Remove(9) {
+		t.Error("Remove reported the wrong result")
+	}
+
+	if v, ok := arr.RemoveAt(-1); v != 4 || !ok {
+		t.Errorf("RemoveAt(-1) = %d, %v, want 4, true", v, ok)
+	}
+
+	if _, ok := arr.RemoveAt(3); ok {
+		t.Error("RemoveAt out of range reported an element")
+	}
+
+	if arr.Shift() != 1 || arr.Pop() != 3 || fmt.Sprint(arr.array) != "[2]" {
+		t.Errorf("after Shift and Pop the array is %v, want [2]", arr.array)
+	}
+
+	arr.Pop()
+	if arr.Pop() != 0 || arr.Shift() != 0 || arr.At(0) != 0 {
+		t.Error("Pop, Shift and At of an empty array did not return zero values")
+	}
+
+	if got := NewSortedWithEntries([]int{3, 1}, cmp.Compare[int]).ToArray(); !slices.Equal(got.array, []int{1, 3}) {
+		t.Errorf("ToArray() = %v, want [1 3]", got.array)
+	}
+}
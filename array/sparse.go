@@ -0,0 +1,791 @@
+package array
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/iVitaliya/javascript-go/internal/bounds"
+)
+
+// SparseArray is an array that can have holes, like the JavaScript array [1, , 3] or an array whose length
+// was set beyond its last element. Only the elements that are present are stored, in a map from index to
+// value, so a SparseArray with a length of a billion and a handful of elements takes a handful of entries.
+//
+// A hole is not the same as an element holding the zero value: Has reports false for it, and the methods
+// follow the rules of the ECMAScript specification for holes. Every, Filter, FlatMap, Flat, ForEach,
+// IndexOf, LastIndexOf, Map, Reduce, ReduceRight and Some skip holes, and Map, Slice, Splice, CopyWithin
+// and Reverse carry them over into their result. At, Entries, Find, FindIndex, FindLast, FindLastIndex,
+// Includes, Values and the change-array-by-copy methods (ToReverse, ToSorted, ToSpliced and With) see a
+// hole as undefined, which is the zero value of T, and Join writes it as an empty string. Sort moves the
+// holes to the end of the array.
+//
+// The methods that skip holes take time proportional to the number of elements, while those that see every
+// index, such as Find, Values and Join, take time proportional to the length of the array.
+//
+// Example:
+//
+//	arr := array.NewSparse[int](5)
+//	arr.Set(1, 10)
+//	arr.Set(3, 30)
+//	arr.ForEach(func(value int, index int, _ *array.SparseArray[int]) {
+//		fmt.Println(index, value) // prints "1 10" and "3 30"
+//	})
+//	arr.Length() // 5
+type SparseArray[T any] struct {
+	values map[int]T
+	length int
+	equal  Equality[T]
+}
+
+// NewSparse returns a new sparse array of the given length made only of holes, like new Array(length).
+// A negative length applies the Policy and results in an empty array.
+func NewSparse[T any](length int) *SparseArray[T] {
+	if length < 0 {
+		report(lengthError("NewSparse", length, 0))
+		length = 0
+	}
+
+	return &SparseArray[T]{
+		values: map[int]T{},
+		length: length,
+	}
+}
+
+// NewSparseWithEntries creates a new sparse array holding the given entries, without holes.
+func NewSparseWithEntries[T any](entries []T) *SparseArray[T] {
+	array := NewSparse[T](0)
+
+	for i, v := range entries {
+		array.values[i] = v
+	}
+
+	array.length = len(entries)
+	return array
+}
+
+// At returns the value at the given index, or a zero value of type T if the index is a hole or out of range.
+// Negative indices count back from the end of the array.
+func (array *SparseArray[T]) At(index int) T {
+	k, ok := bounds.Relative(index, array.length)
+	if !ok {
+		return *new(T)
+	}
+
+	return array.values[k]
+}
+
+// Append adds the given values to the end of the array.
+func (array *SparseArray[T]) Append(value ...T) {
+	array.Concat(value)
+}
+
+// Concat appends the elements of the given slices to the end of the array.
+func (array *SparseArray[T]) Concat(elements ...[]T) {
+	for _, values := range elements {
+		for _, v := range values {
+			array.values[array.length] = v
+			array.length++
+		}
+	}
+}
+
+// CopyWithin copies the elements from the start index up to but not including the end index to the target
+// index, like Array.CopyWithin. Holes are copied too: a target whose source is a hole becomes a hole.
+func (array *SparseArray[T]) CopyWithin(target, start int, end ...int) *SparseArray[T] {
+	if reporting() {
+		report(checkCopyWithin(target, start, end, array.length))
+	}
+
+	var (
+		to    = bounds.Clamp(target, array.length)
+		from  = bounds.Clamp(start, array.length)
+		count = min(bounds.End(end, array.length)-from, array.length-to)
+	)
+
+	if count <= 0 {
+		return array
+	}
+
+	source := map[int]T{}
+	for _, k := range array.indices(from, from+count) {
+		source[k-from] = array.values[k]
+	}
+
+	for _, k := range array.indices(to, to+count) {
+		delete(array.values, k)
+	}
+
+	for offset, v := range source {
+		array.values[to+offset] = v
+	}
+
+	return array
+}
+
+// Delete removes the element at the given index, leaving a hole, and reports whether there was an element.
+// Unlike Splice, it does not move the elements after it or change the length of the array.
+// Negative indices count back from the end of the array.
+func (array *SparseArray[T]) Delete(index int) bool {
+	k, ok := bounds.Relative(index, array.length)
+	if !ok {
+		return false
+	}
+
+	_, found := array.values[k]
+	delete(array.values, k)
+
+	return found
+}
+
+// Elements returns an iterator over the index/value pairs of the elements that are present,
+// in ascending order of index. Unlike Entries, it skips holes.
+func (array *SparseArray[T]) Elements() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for _, k := range array.indices(0, array.length) {
+			v, ok := array.values[k]
+			if !ok || k >= array.length {
+				continue
+			}
+
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Entries returns an iterator over the index/value pairs of the array, like Array.prototype.entries.
+// Holes are visited too, with a zero value of type T.
+func (array *SparseArray[T]) Entries() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < array.length; i++ {
+			if !yield(i, array.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Every tests whether all elements of the array pass the test implemented by the provided function,
+// skipping holes. The callback function takes the element value, its index and the array itself.
+func (array *SparseArray[T]) Every(fn func(value T, index int, array *SparseArray[T]) bool) bool {
+	for k, v := range array.visit(false) {
+		if !fn(v, k, array) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Fill fills all the elements of the array from a start index to an end index with a static value,
+// like Array.Fill. Holes in the range are filled as well.
+func (array *SparseArray[T]) Fill(element T, start int, end ...int) *SparseArray[T] {
+	if reporting() {
+		_, _, err := checkRange("Fill", start, end, array.length)
+		report(err)
+	}
+
+	for i, final := bounds.Clamp(start, array.length), bounds.End(end, array.length); i < final; i++ {
+		array.values[i] = element
+	}
+
+	return array
+}
+
+// Filter returns a new array with all elements that pass the test implemented by the provided function,
+// skipping holes. The result has no holes.
+// The callback function takes the element value, its index and the array itself.
+func (array *SparseArray[T]) Filter(fn func(value T, index int, array *SparseArray[T]) bool) []T {
+	result := []T{}
+
+	for k, v := range array.visit(false) {
+		if fn(v, k, array) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// Find returns the first element of the array that satisfies the provided testing function, and true.
+// Holes are visited too, with a zero value of type T.
+// The callback function takes the element value, its index and the array itself.
+func (array *SparseArray[T]) Find(fn func(value T, index int, array *SparseArray[T]) bool) (T, bool) {
+	index, ok := array.FindIndex(fn)
+	if !ok {
+		return *new(T), false
+	}
+
+	return array.values[index], true
+}
+
+// FindIndex returns the index of the first element of the array that satisfies the provided testing function,
+// and true. Holes are visited too, with a zero value of type T.
+func (array *SparseArray[T]) FindIndex(fn func(value T, index int, array *SparseArray[T]) bool) (int, bool) {
+	for i, length := 0, array.length; i < length; i++ {
+		if fn(array.values[i], i, array) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// FindLast returns the last element of the array that satisfies the provided testing function, and true.
+// Holes are visited too, with a zero value of type T.
+func (array *SparseArray[T]) FindLast(fn func(value T, index int, array *SparseArray[T]) bool) (T, bool) {
+	index, ok := array.FindLastIndex(fn)
+	if !ok {
+		return *new(T), false
+	}
+
+	return array.values[index], true
+}
+
+// FindLastIndex returns the index of the last element of the array that satisfies the provided testing
+// function, and true. Holes are visited too, with a zero value of type T.
+func (array *SparseArray[T]) FindLastIndex(fn func(value T, index int, array *SparseArray[T]) bool) (int, bool) {
+	for i := array.length - 1; i >= 0; i-- {
+		if fn(array.values[i], i, array) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// Flat returns a new array with the elements of the array that are lists flattened into it, like Array.Flat.
+// Holes are skipped, so the result has none.
+func (array *SparseArray[T]) Flat(depth int) ([]T, error) {
+	return flattenInto([]T{}, slices.Collect(array.visit(false).values()), depth)
+}
+
+// FlatMap maps each element of the array with the provided function and flattens the results one level deep
+// into a new array, skipping holes. The callback function takes the element value, its index and the array itself.
+func (array *SparseArray[T]) FlatMap(fn func(value T, index int, array *SparseArray[T]) []T) []T {
+	result := []T{}
+
+	for k, v := range array.visit(false) {
+		result = append(result, fn(v, k, array)...)
+	}
+
+	return result
+}
+
+// ForEach calls the provided function once for each element present in the array in ascending order,
+// skipping holes. Elements deleted by the callback before they are reached are not visited, nor are
+// elements added by it. The callback function takes the element value, its index and the array itself.
+func (array *SparseArray[T]) ForEach(fn func(value T, index int, array *SparseArray[T])) {
+	for k, v := range array.visit(false) {
+		fn(v, k, array)
+	}
+}
+
+// Has reports whether there is an element at the given index, as opposed to a hole.
+// Negative indices count back from the end of the array.
+func (array *SparseArray[T]) Has(index int) bool {
+	k, ok := bounds.Relative(index, array.length)
+	if !ok {
+		return false
+	}
+
+	_, found := array.values[k]
+	return found
+}
+
+// Includes determines whether the array includes a certain element, returning true or false as appropriate.
+// Holes count as zero values of type T, so Includes of the zero value is true for an array with holes.
+// The optional fromIndex is the position at which to begin searching; if it is negative,
+// it is treated as an offset from the end of the array.
+func (array *SparseArray[T]) Includes(search_term T, fromIndex ...int) bool {
+	var from int
+	if len(fromIndex) > 0 {
+		from = bounds.Clamp(fromIndex[0], array.length)
+	}
+
+	var (
+		present = array.indices(from, array.length)
+		equal   = array.equality()
+	)
+
+	for _, k := range present {
+		if equal(array.values[k], search_term) {
+			return true
+		}
+	}
+
+	return len(present) < array.length-from && equal(*new(T), search_term)
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in the array, or -1 if it is
+// not present. Holes are skipped. The optional fromIndex is the position at which to begin searching;
+// if it is negative, it is treated as an offset from the end of the array.
+func (array *SparseArray[T]) IndexOf(search_term T, fromIndex ...int) int {
+	var from int
+	if len(fromIndex) > 0 {
+		from = bounds.Clamp(fromIndex[0], array.length)
+	}
+
+	equal := array.equality()
+	for _, k := range array.indices(from, array.length) {
+		if equal(array.values[k], search_term) {
+			return k
+		}
+	}
+
+	return -1
+}
+
+// Join joins all elements of the array into a string, separated by the given separator.
+// Elements are converted with fmt.Sprint like Array.Join, while holes become empty strings.
+func (array *SparseArray[T]) Join(separator string) string {
+	var b strings.Builder
+
+	for i := 0; i < array.length; i++ {
+		if i > 0 {
+			b.WriteString(separator)
+		}
+
+		if v, ok := array.values[i]; ok {
+			b.WriteString(fmt.Sprint(v))
+		}
+	}
+
+	return b.String()
+}
+
+// Keys returns an iterator over every index of the array, holes included, like Array.prototype.keys.
+func (array *SparseArray[T]) Keys() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < array.length; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// LastIndexOf returns the index of the last occurrence of the specified element in the array, or -1 if it
+// is not present. Holes are skipped. The optional fromIndex is the position at which to begin searching
+// backwards; if it is negative, it is treated as an offset from the end of the array.
+func (array *SparseArray[T]) LastIndexOf(search_term T, fromIndex ...int) int {
+	from := array.length - 1
+	if len(fromIndex) > 0 {
+		if fromIndex[0] < 0 {
+			from = array.length + fromIndex[0]
+		} else if fromIndex[0] < from {
+			from = fromIndex[0]
+		}
+	}
+
+	var (
+		present = array.indices(0, from+1)
+		equal   = array.equality()
+	)
+
+	for i := len(present) - 1; i >= 0; i-- {
+		if equal(array.values[present[i]], search_term) {
+			return present[i]
+		}
+	}
+
+	return -1
+}
+
+// Length returns the length of the array, holes included.
+func (array *SparseArray[T]) Length() int {
+	return array.length
+}
+
+// Map returns a new sparse array of the same length with the results of calling the provided function on
+// every element of the array. Holes are skipped and stay holes in the result.
+// The callback function takes the element value, its index and the array itself.
+func (array *SparseArray[T]) Map(fn func(value T, index int, array *SparseArray[T]) T) *SparseArray[T] {
+	result := array.derive(array.length)
+
+	for k, v := range array.visit(false) {
+		result.values[k] = fn(v, k, array)
+	}
+
+	return result
+}
+
+// Pop removes the last element from the array and returns it, shortening the array by one.
+// If the last element is a hole, or the array is empty, it returns a zero value of type T.
+func (array *SparseArray[T]) Pop() T {
+	if array.length == 0 {
+		return *new(T)
+	}
+
+	array.length--
+
+	result := array.values[array.length]
+	delete(array.values, array.length)
+
+	return result
+}
+
+// Push adds the given value to the end of the array.
+func (array *SparseArray[T]) Push(value T) {
+	array.values[array.length] = value
+	array.length++
+}
+
+// Reduce applies a function against an accumulator and each element in the array (from left to right),
+// skipping holes. The initial value is the first element present in the array. If there is none, it returns
+// a zero value of type T.
+func (array *SparseArray[T]) Reduce(fn func(accumulator T, value T, index int, array *SparseArray[T]) T) T {
+	return array.reduce(false, fn)
+}
+
+// ReduceRight applies a function against an accumulator and each element in the array (from right to left),
+// skipping holes. The initial value is the last element present in the array. If there is none, it returns
+// a zero value of type T.
+func (array *SparseArray[T]) ReduceRight(fn func(accumulator T, value T, index int, array *SparseArray[T]) T) T {
+	return array.reduce(true, fn)
+}
+
+// Reverse reverses the elements of the array in place. Holes are reversed along with the elements.
+func (array *SparseArray[T]) Reverse() {
+	values := make(map[int]T, len(array.values))
+	for k, v := range array.values {
+		values[array.length-1-k] = v
+	}
+
+	array.values = values
+}
+
+// Set sets the element at the given index, filling a hole or replacing an element.
+// Setting an index at or past the end of the array grows the array to include it, leaving holes in between,
+// like assigning arr[index] in JavaScript. A negative index applies the Policy and does nothing.
+func (array *SparseArray[T]) Set(index int, value T) {
+	if index < 0 {
+		report(indexError("Set", index, array.length))
+		return
+	}
+
+	array.values[index] = value
+	array.length = max(array.length, index+1)
+}
+
+// SetEquality changes the equality function used by Includes, IndexOf and LastIndexOf to compare elements.
+// Passing nil restores the default SameValueZero comparison.
+// The return value is the array itself, so the call can be chained.
+func (array *SparseArray[T]) SetEquality(equal Equality[T]) *SparseArray[T] {
+	array.equal = equal
+
+	return array
+}
+
+// SetLength changes the length of the array, like assigning to arr.length in JavaScript.
+// Growing the array adds holes at the end, and shrinking it deletes the elements past the new length.
+// If the length is negative, it returns a *RangeError and leaves the array unchanged.
+func (array *SparseArray[T]) SetLength(length int) error {
+	if length < 0 {
+		return lengthError("SetLength", length, array.length)
+	}
+
+	for _, k := range array.indices(length, array.length) {
+		delete(array.values, k)
+	}
+
+	array.length = length
+	return nil
+}
+
+// Shift removes the first element from the array and returns it, moving the other elements and holes down
+// by one. If the first element is a hole, or the array is empty, it returns a zero value of type T.
+func (array *SparseArray[T]) Shift() T {
+	if array.length == 0 {
+		return *new(T)
+	}
+
+	result := array.values[0]
+	array.splice(0, 1, nil)
+
+	return result
+}
+
+// Slice returns a new sparse array with a portion of the array from the start index to the end index
+// (exclusive), like Array.Slice. Holes in the portion stay holes in the result.
+func (array *SparseArray[T]) Slice(start int, end ...int) *SparseArray[T] {
+	if reporting() {
+		_, _, err := checkRange("Slice", start, end, array.length)
+		report(err)
+	}
+
+	var (
+		from  = bounds.Clamp(start, array.length)
+		final = bounds.End(end, array.length)
+	)
+
+	result := array.derive(max(final-from, 0))
+	for _, k := range array.indices(from, final) {
+		result.values[k-from] = array.values[k]
+	}
+
+	return result
+}
+
+// Some tests whether at least one element in the array passes the test implemented by the provided function,
+// skipping holes. The callback function takes the element value, its index and the array itself.
+func (array *SparseArray[T]) Some(fn func(value T, index int, array *SparseArray[T]) bool) bool {
+	for k, v := range array.visit(false) {
+		if fn(v, k, array) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sort sorts the elements of the array in place, like Array.Sort. The elements are moved to the start of the
+// array and the holes to the end, after any nil elements, as Array.prototype.sort does; the length is unchanged.
+func (array *SparseArray[T]) Sort(fn ...func(a, b T) int) {
+	sorted := slices.Collect(array.visit(false).values())
+	sortStable(sorted, fn)
+
+	values := make(map[int]T, len(sorted))
+	for i, v := range sorted {
+		values[i] = v
+	}
+
+	array.values = values
+}
+
+// Splice removes, replaces or inserts elements in place and returns the removed elements, like Array.Splice.
+// Holes after the changed part move along with the elements, and holes among the removed elements stay holes
+// in the returned array.
+func (array *SparseArray[T]) Splice(start, deleteCount int, items ...T) *SparseArray[T] {
+	if reporting() {
+		_, _, err := checkSplice("Splice", start, deleteCount, array.length)
+		report(err)
+	}
+
+	start, deleteCount = spliceBounds(start, deleteCount, array.length)
+
+	return array.splice(start, deleteCount, items)
+}
+
+// ToArray returns a new Array holding the elements of the sparse array, with a zero value of type T for
+// every hole, as Array.from does with undefined.
+func (array *SparseArray[T]) ToArray() *Array[T] {
+	return &Array[T]{
+		array: array.dense(),
+		equal: array.equal,
+	}
+}
+
+// ToReverse returns a new slice with the elements of the array in reverse order.
+// Holes become zero values of type T, as in Array.prototype.toReversed.
+func (array *SparseArray[T]) ToReverse() []T {
+	result := array.dense()
+	slices.Reverse(result)
+
+	return result
+}
+
+// ToSorted returns a new slice with the elements of the array sorted, like Array.ToSorted.
+// Holes become zero values of type T and are placed at the end, as undefined is in Array.prototype.toSorted.
+func (array *SparseArray[T]) ToSorted(fn ...func(a, b T) int) []T {
+	result := slices.Collect(array.visit(false).values())
+	sortStable(result, fn)
+
+	return append(result, make([]T, array.length-len(result))...)
+}
+
+// ToSpliced returns a new slice with elements added, removed, or replaced, like Array.ToSpliced.
+// Holes become zero values of type T, as in Array.prototype.toSpliced.
+func (array *SparseArray[T]) ToSpliced(start, deleteCount int, items ...T) []T {
+	if reporting() {
+		_, _, err := checkSplice("ToSpliced", start, deleteCount, array.length)
+		report(err)
+	}
+
+	return array.ToArray().ToSpliced(start, deleteCount, items...)
+}
+
+// ToString returns a string representation of the array, like Array.ToString, with holes shown as empty.
+func (array *SparseArray[T]) ToString() string {
+	return "[" + array.Join(" ") + "]"
+}
+
+// Unshift adds one or more elements to the beginning of the array and returns the new length of the array.
+// The elements and holes already in the array move up.
+func (array *SparseArray[T]) Unshift(elements ...T) int {
+	array.splice(0, 0, elements)
+
+	return array.length
+}
+
+// Values returns an iterator over the elements of the array, like Array.prototype.values.
+// Holes are visited too, with a zero value of type T.
+func (array *SparseArray[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < array.length; i++ {
+			if !yield(array.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// With returns a new slice with the value at the given index replaced, like Array.With.
+// Holes become zero values of type T. If the index is out of range, it returns a *RangeError.
+func (array *SparseArray[T]) With(index int, value T) ([]T, error) {
+	k, err := checkIndex("With", index, array.length)
+	if err != nil {
+		return nil, err
+	}
+
+	result := array.dense()
+	result[k] = value
+
+	return result, nil
+}
+
+// equality returns the equality function of the array, or SameValueZero resolved for its element type.
+func (array *SparseArray[T]) equality() Equality[T] {
+	if array.equal == nil {
+		return sameValueZero[T]()
+	}
+
+	return array.equal
+}
+
+// derive returns a new sparse array of the given length made of holes, using the equality function of the array.
+func (array *SparseArray[T]) derive(length int) *SparseArray[T] {
+	return &SparseArray[T]{
+		values: map[int]T{},
+		length: length,
+		equal:  array.equal,
+	}
+}
+
+// dense returns the elements of the array in a slice, with a zero value of type T for every hole.
+func (array *SparseArray[T]) dense() []T {
+	result := make([]T, array.length)
+	for k, v := range array.values {
+		result[k] = v
+	}
+
+	return result
+}
+
+// indices returns the indices in [from, to) that hold an element, in ascending order. It takes time
+// proportional to the smaller of the size of the range and the number of elements in the array.
+func (array *SparseArray[T]) indices(from, to int) []int {
+	var result []int
+
+	if to <= from {
+		return result
+	}
+
+	if to-from <= len(array.values) {
+		for i := from; i < to; i++ {
+			if _, ok := array.values[i]; ok {
+				result = append(result, i)
+			}
+		}
+
+		return result
+	}
+
+	for k := range array.values {
+		if k >= from && k < to {
+			result = append(result, k)
+		}
+	}
+
+	slices.Sort(result)
+	return result
+}
+
+// sparseVisit is an iterator over the elements of a sparse array that are present.
+type sparseVisit[T any] iter.Seq2[int, T]
+
+// values returns an iterator over the values yielded by the visit.
+func (visit sparseVisit[T]) values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range visit {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// visit returns an iterator over the elements present below the current length, in ascending order or,
+// if reverse is true, in descending order. Following the HasProperty checks of the specification, an element
+// deleted, or cut off by a shorter length, before it is reached is skipped.
+func (array *SparseArray[T]) visit(reverse bool) sparseVisit[T] {
+	return func(yield func(int, T) bool) {
+		present := array.indices(0, array.length)
+		if reverse {
+			slices.Reverse(present)
+		}
+
+		for _, k := range present {
+			v, ok := array.values[k]
+			if !ok || k >= array.length {
+				continue
+			}
+
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// reduce implements Reduce and ReduceRight.
+func (array *SparseArray[T]) reduce(reverse bool, fn func(accumulator T, value T, index int, array *SparseArray[T]) T) T {
+	var (
+		result T
+		first  = true
+	)
+
+	for k, v := range array.visit(reverse) {
+		if first {
+			result, first = v, false
+			continue
+		}
+
+		result = fn(result, v, k, array)
+	}
+
+	return result
+}
+
+// splice removes deleteCount elements from the resolved start index and inserts the items in their place,
+// moving the elements and holes after them. It returns the removed elements and holes.
+func (array *SparseArray[T]) splice(start, deleteCount int, items []T) *SparseArray[T] {
+	var (
+		removed = array.derive(deleteCount)
+		delta   = len(items) - deleteCount
+		values  = make(map[int]T, len(array.values)+len(items))
+	)
+
+	for k, v := range array.values {
+		switch {
+		case k < start:
+			values[k] = v
+		case k < start+deleteCount:
+			removed.values[k-start] = v
+		default:
+			values[k+delta] = v
+		}
+	}
+
+	for i, v := range items {
+		values[start+i] = v
+	}
+
+	array.values = values
+	array.length += delta
+
+	return removed
+}
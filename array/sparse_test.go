@@ -0,0 +1,183 @@
+package array
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// The expected values follow what JavaScript does with the array [1, , 3, , 5], which has holes at 1 and 3.
+
+// newHoley returns the sparse array [1, , 3, , 5].
+func newHoley() *SparseArray[int] {
+	arr := NewSparse[int](5)
+	arr.Set(0, 1)
+	arr.Set(2, 3)
+	arr.Set(4, 5)
+
+	return arr
+}
+
+// layout shows the elements of the sparse array separated by commas, with an underscore for each hole.
+func layout[T any](arr *SparseArray[T]) string {
+	parts := make([]string, arr.Length())
+	for i := range parts {
+		parts[i] = "_"
+		if arr.Has(i) {
+			parts[i] = fmt.Sprint(arr.At(i))
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func TestSparseHoles(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(arr *SparseArray[int]) any
+		want any
+	}{
+		{"ForEach skips holes", func(arr *SparseArray[int]) any {
+			visited := []int{}
+			arr.ForEach(func(value, index int, array *SparseArray[int]) {
+				visited = append(visited, index)
+			})
+			return fmt.Sprint(visited)
+		}, "[0 2 4]"},
+		{"Map keeps holes", func(arr *SparseArray[int]) any {
+			return layout(arr.Map(func(value, index int, array *SparseArray[int]) int { return value * 2 }))
+		}, "2,_,6,_,10"},
+		{"Filter skips holes", func(arr *SparseArray[int]) any {
+			return fmt.Sprint(arr.Filter(func(value, index int, array *SparseArray[int]) bool { return true }))
+		}, "[1 3 5]"},
+		{"Every skips holes", func(arr *SparseArray[int]) any {
+			return arr.Every(func(value, index int, array *SparseArray[int]) bool { return value != 0 })
+		}, true},
+		{"Some skips holes", func(arr *SparseArray[int]) any {
+			return arr.Some(func(value, index int, array *SparseArray[int]) bool { return value == 0 })
+		}, false},
+		{"Reduce skips holes", func(arr *SparseArray[int]) any {
+			return arr.Reduce(func(accumulator, value, index int, array *SparseArray[int]) int { return accumulator + value })
+		}, 9},
+		{"IndexOf skips holes", func(arr *SparseArray[int]) any { return arr.IndexOf(0) }, -1},
+		{"Includes sees holes as undefined", func(arr *SparseArray[int]) any { return arr.Includes(0) }, true},
+		{"FindIndex sees holes as undefined", func(arr *SparseArray[int]) any {
+			index, _ := arr.FindIndex(func(value, index int, array *SparseArray[int]) bool { return value == 0 })
+			return index
+		}, 1},
+		{"At does not skip holes", func(arr *SparseArray[int]) any { return fmt.Sprint(arr.At(1), arr.At(-2)) }, "0 0"},
+		{"Has reports holes", func(arr *SparseArray[int]) any { return fmt.Sprint(arr.Has(0), arr.Has(1)) }, "true false"},
+		{"Fill fills holes", func(arr *SparseArray[int]) any { return layout(arr.Fill(7, 1, 4)) }, "1,7,7,7,5"},
+		{"Join writes holes as empty strings", func(arr *SparseArray[int]) any { return arr.Join(",") }, "1,,3,,5"},
+		{"Values sees holes", func(arr *SparseArray[int]) any {
+			values := []int{}
+			for v := range arr.Values() {
+				values = append(values, v)
+			}
+			return fmt.Sprint(values)
+		}, "[1 0 3 0 5]"},
+		{"Slice keeps holes", func(arr *SparseArray[int]) any { return layout(arr.Slice(1, 4)) }, "_,3,_"},
+		{"Reverse keeps holes", func(arr *SparseArray[int]) any { arr.Reverse(); return layout(arr) }, "5,_,3,_,1"},
+		{"Sort moves holes to the end", func(arr *SparseArray[int]) any {
+			arr.Set(0, 9)
+			arr.Sort()
+			return layout(arr)
+		}, "3,5,9,_,_"},
+		{"Splice moves holes", func(arr *SparseArray[int]) any { arr.Splice(0, 1); return layout(arr) }, "_,3,_,5"},
+		{"ToSpliced fills holes", func(arr *SparseArray[int]) any { return fmt.Sprint(arr.ToSpliced(0, 1)) }, "[0 3 0 5]"},
+		{"Pop of a hole", func(arr *SparseArray[int]) any {
+			arr.Pop()
+			return fmt.Sprint(arr.Pop(), " ", layout(arr))
+		}, "0 1,_,3"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.run(newHoley()); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSparseLength(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(arr *SparseArray[int])
+		want string
+	}{
+		{"growing adds holes", func(arr *SparseArray[int]) { arr.SetLength(7) }, "1,_,3,_,5,_,_"},
+		{"truncating deletes elements", func(arr *SparseArray[int]) { arr.SetLength(3) }, "1,_,3"},
+		{"truncating then growing leaves holes", func(arr *SparseArray[int]) {
+			arr.SetLength(1)
+			arr.SetLength(3)
+		}, "1,_,_"},
+		{"setting past the end grows the array", func(arr *SparseArray[int]) { arr.Set(7, 8) }, "1,_,3,_,5,_,_,8"},
+		{"pushing after growing", func(arr *SparseArray[int]) {
+			arr.SetLength(6)
+			arr.Push(6)
+		}, "1,_,3,_,5,_,6"},
+		{"unshifting moves holes", func(arr *SparseArray[int]) { arr.Unshift(0) }, "0,1,_,3,_,5"},
+	}
+
+	for _, tt := range tests {
+		arr := newHoley()
+		tt.run(arr)
+
+		if got := layout(arr); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+
+	arr := newHoley()
+	if err := arr.SetLength(-1); !errors.Is(err, ErrInvalidLength) || arr.Length() != 5 {
+		t.Errorf("SetLength(-1) = %v leaving length %d, want ErrInvalidLength leaving 5", err, arr.Length())
+	}
+
+	if got := NewSparse[int](3); got.Length() != 3 || layout(got) != "_,_,_" {
+		t.Errorf("NewSparse(3) = %s, want three holes", layout(got))
+	}
+}
+
+func TestSparseDelete(t *testing.T) {
+	arr := newHoley()
+
+	if !arr.Delete(2) {
+		t.Error("Delete(2) reported no element")
+	}
+
+	if arr.Delete(2) || arr.Delete(1) {
+		t.Error("Delete of a hole reported an element")
+	}
+
+	if !arr.Delete(-1) {
+		t.Error("Delete(-1) reported no element")
+	}
+
+	if got, want := layout(arr), "1,_,_,_,_"; got != want {
+		t.Errorf("after Delete got %s, want %s", got, want)
+	}
+
+	if arr.Length() != 5 {
+		t.Errorf("Delete changed the length to %d", arr.Length())
+	}
+}
+
+func TestSparseHugeLength(t *testing.T) {
+	arr := NewSparse[int](1_000_000_000)
+	arr.Set(10, 1)
+	arr.Set(999_999_999, 2)
+
+	visited := []int{}
+	arr.ForEach(func(value, index int, array *SparseArray[int]) {
+		visited = append(visited, index)
+	})
+
+	if !slices.Equal(visited, []int{10, 999_999_999}) || len(arr.values) != 2 {
+		t.Errorf("ForEach visited %v with %d entries stored, want [10 999999999] with 2", visited, len(arr.values))
+	}
+
+	if got := arr.LastIndexOf(1); got != 10 {
+		t.Errorf("LastIndexOf(1) = %d, want 10", got)
+	}
+}
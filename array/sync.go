@@ -0,0 +1,453 @@
+package array
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/iVitaliya/javascript-go/internal/bounds"
+)
+
+// SyncArray is an array that is safe for concurrent use by multiple goroutines.
+// It has the same methods as Array, each guarded by a read/write mutex, plus a few atomic
+// compound operations such as PushIfAbsent and CompareAndSwapAt.
+//
+// Methods that call back into user code (ForEach, Map, Filter, Find and friends) run the callback
+// against a snapshot of the array taken under the lock, so callbacks never observe a half-applied
+// write and may safely call other methods of the SyncArray. The exceptions are Sort and ToSorted,
+// whose comparison function runs while the lock is held and must not call back into the SyncArray.
+//
+// Slices returned by SyncArray methods are always copies, never views over the shared elements.
+type SyncArray[T any] struct {
+	mu    sync.RWMutex
+	array *Array[T]
+}
+
+// NewSync returns a new empty array that is safe for concurrent use.
+//
+// Example:
+//
+//	arr := array.NewSync[int]()
+//	go arr.Push(1)
+func NewSync[T any]() *SyncArray[T] {
+	return &SyncArray[T]{
+		array: New[T](),
+	}
+}
+
+// NewSyncWithEntries creates a new array that is safe for concurrent use, holding a copy of the given entries.
+func NewSyncWithEntries[T any](entries []T) *SyncArray[T] {
+	return &SyncArray[T]{
+		array: NewWithEntries[T](entries),
+	}
+}
+
+// Snapshot returns a copy of the array as it is at the moment of the call.
+// The copy is an ordinary Array and is not affected by later changes to the SyncArray.
+func (array *SyncArray[T]) Snapshot() *Array[T] {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.clone()
+}
+
+// Update calls the provided function with the underlying array while holding the write lock,
+// so that any sequence of reads and writes made by the function is applied atomically.
+// The function must not retain the array or call back into the SyncArray.
+func (array *SyncArray[T]) Update(fn func(array *Array[T])) {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	fn(array.array)
+}
+
+// PushIfAbsent adds the given value to the end of the array unless the array already includes it.
+// The check and the push happen atomically. It returns true if the value was added.
+func (array *SyncArray[T]) PushIfAbsent(value T) bool {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	if array.array.Includes(value) {
+		return false
+	}
+
+	array.array.Push(value)
+	return true
+}
+
+// CompareAndSwapAt replaces the value at the given index with new if the current value equals old,
+// using the equality function of the array. Negative indices count back from the end of the array.
+// The comparison and the swap happen atomically. It returns true if the value was swapped.
+func (array *SyncArray[T]) CompareAndSwapAt(index int, old, new T) bool {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	k, ok := bounds.Relative(index, len(array.array.array))
+	if !ok || !array.array.equality()(array.array.array[k], old) {
+		return false
+	}
+
+	array.array.array[k] = new
+	return true
+}
+
+// At returns the value at the given index. See Array.At.
+func (array *SyncArray[T]) At(index int) T {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.At(index)
+}
+
+// Append adds the given values to the end of the array. See Array.Append.
+func (array *SyncArray[T]) Append(value ...T) {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	array.array.Append(value...)
+}
+
+// Concat appends the elements of the given arrays to the end of the array. See Array.Concat.
+func (array *SyncArray[T]) Concat(elements ...[]T) {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	array.array.Concat(elements...)
+}
+
+// CopyWithin copies part of the array to another location in the same array, and returns a copy
+// of the modified array. See Array.CopyWithin.
+func (array *SyncArray[T]) CopyWithin(target, start int, end ...int) []T {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	return cloneSlice(array.array.CopyWithin(target, start, end...))
+}
+
+// Entries returns an iterator over the index/value pairs of a snapshot of the array.
+// Unlike Array.Entries, changes made to the SyncArray during iteration are not observed.
+func (array *SyncArray[T]) Entries() iter.Seq2[int, T] {
+	return array.Snapshot().Entries()
+}
+
+// Every tests whether all elements in a snapshot of the array pass the provided test. See Array.Every.
+func (array *SyncArray[T]) Every(fn func(value T, index int, array *Array[T]) bool) bool {
+	return array.Snapshot().Every(fn)
+}
+
+// Fill fills the elements of the array from a start index to an end index with a static value,
+// and returns a copy of the modified array. See Array.Fill.
+func (array *SyncArray[T]) Fill(element T, start int, end ...int) []T {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	return cloneSlice(array.array.Fill(element, start, end...))
+}
+
+// Filter returns the elements of a snapshot of the array that pass the provided test. See Array.Filter.
+func (array *SyncArray[T]) Filter(fn func(value T, index int, array *Array[T]) bool) []T {
+	return array.Snapshot().Filter(fn)
+}
+
+// Find returns the first element of a snapshot of the array that satisfies the provided test. See Array.Find.
+func (array *SyncArray[T]) Find(fn func(value T, index int, array *Array[T]) bool) (T, bool) {
+	return array.Snapshot().Find(fn)
+}
+
+// FindIndex returns the index of the first element of a snapshot of the array that satisfies the provided test.
+// See Array.FindIndex.
+func (array *SyncArray[T]) FindIndex(fn func(value T, index int, array *Array[T]) bool) (int, bool) {
+	return array.Snapshot().FindIndex(fn)
+}
+
+// FindLast returns the last element of a snapshot of the array that satisfies the provided test. See Array.FindLast.
+func (array *SyncArray[T]) FindLast(fn func(value T, index int, array *Array[T]) bool) (T, bool) {
+	return array.Snapshot().FindLast(fn)
+}
+
+// FindLastIndex returns the index of the last element of a snapshot of the array that satisfies the provided test.
+// See Array.FindLastIndex.
+func (array *SyncArray[T]) FindLastIndex(fn func(value T, index int, array *Array[T]) bool) (int, bool) {
+	return array.Snapshot().FindLastIndex(fn)
+}
+
+// Flat returns a new array with the elements of the array flattened. See Array.Flat.
+func (array *SyncArray[T]) Flat(depth int) ([]T, error) {
+	return array.Snapshot().Flat(depth)
+}
+
+// FlatMap maps each element of a snapshot of the array and flattens the results one level deep. See Array.FlatMap.
+func (array *SyncArray[T]) FlatMap(fn func(value T, index int, array *Array[T]) []T) []T {
+	return array.Snapshot().FlatMap(fn)
+}
+
+// ForEach calls the provided function once for each element of a snapshot of the array. See Array.ForEach.
+func (array *SyncArray[T]) ForEach(fn func(value T, index int, array *Array[T])) {
+	array.Snapshot().ForEach(fn)
+}
+
+// Includes determines whether the array includes a certain element. See Array.Includes.
+func (array *SyncArray[T]) Includes(search_term T, fromIndex ...int) bool {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.Includes(search_term, fromIndex...)
+}
+
+// IndexOf returns the index of the first occurrence of the specified element in the array. See Array.IndexOf.
+func (array *SyncArray[T]) IndexOf(search_term T, fromIndex ...int) int {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.IndexOf(search_term, fromIndex...)
+}
+
+// Join joins all elements of the array into a string. See Array.Join.
+func (array *SyncArray[T]) Join(separator string) string {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.Join(separator)
+}
+
+// Keys returns an iterator over the indices of a snapshot of the array.
+func (array *SyncArray[T]) Keys() iter.Seq[int] {
+	return array.Snapshot().Keys()
+}
+
+// Length returns the number of elements in the array.
+func (array *SyncArray[T]) Length() int {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.Length()
+}
+
+// LastIndexOf returns the index of the last occurrence of the specified element in the array. See Array.LastIndexOf.
+func (array *SyncArray[T]) LastIndexOf(search_term T, fromIndex ...int) int {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.LastIndexOf(search_term, fromIndex...)
+}
+
+// Map returns the results of calling the provided function on each element of a snapshot of the array. See Array.Map.
+func (array *SyncArray[T]) Map(fn func(value T, index int, array *Array[T]) T) []T {
+	return array.Snapshot().Map(fn)
+}
+
+// Pop removes the last element from the array and returns it. See Array.Pop.
+func (array *SyncArray[T]) Pop() T {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	return array.array.Pop()
+}
+
+// Reduce reduces a snapshot of the array to a single value from left to right. See Array.Reduce.
+func (array *SyncArray[T]) Reduce(fn func(accumulator T, value T, index int, array *Array[T]) T) T {
+	return array.Snapshot().Reduce(fn)
+}
+
+// ReduceRight reduces a snapshot of the array to a single value from right to left. See Array.ReduceRight.
+func (array *SyncArray[T]) ReduceRight(fn func(accumulator T, value T, index int, array *Array[T]) T) T {
+	return array.Snapshot().ReduceRight(fn)
+}
+
+// Reverse reverses the elements of the array in place. See Array.Reverse.
+func (array *SyncArray[T]) Reverse() {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	array.array.Reverse()
+}
+
+// Push adds the given value to the end of the array and returns its new length. See Array.Push.
+func (array *SyncArray[T]) Push(value T) int {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	return array.array.Push(value)
+}
+
+// Shift removes the first element from the array and returns it. See Array.Shift.
+func (array *SyncArray[T]) Shift() T {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	return array.array.Shift()
+}
+
+// Slice returns a shallow copy of a portion of the array. See Array.Slice.
+func (array *SyncArray[T]) Slice(start int, end ...int) []T {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.Slice(start, end...)
+}
+
+// Some tests whether at least one element of a snapshot of the array passes the provided test. See Array.Some.
+func (array *SyncArray[T]) Some(fn func(value T, index int, array *Array[T]) bool) bool {
+	return array.Snapshot().Some(fn)
+}
+
+// Sort sorts the elements of the array in place. See Array.Sort.
+// The comparison function is called while the lock is held and must not call back into the SyncArray.
+func (array *SyncArray[T]) Sort(fn ...func(a, b T) int) {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	array.array.Sort(fn...)
+}
+
+// Splice removes, replaces or inserts elements in place and returns the removed elements. See Array.Splice.
+func (array *SyncArray[T]) Splice(start, deleteCount int, items ...T) *Array[T] {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	return array.array.Splice(start, deleteCount, items...)
+}
+
+// ToReverse returns a new array with the elements of the array in reverse order. See Array.ToReverse.
+func (array *SyncArray[T]) ToReverse() []T {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.ToReverse()
+}
+
+// ToSorted returns a new array with the elements of the array sorted. See Array.ToSorted.
+// The comparison function is called while the lock is held and must not call back into the SyncArray.
+func (array *SyncArray[T]) ToSorted(fn ...func(a, b T) int) []T {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.ToSorted(fn...)
+}
+
+// ToSpliced returns a new array with elements added, removed or replaced. See Array.ToSpliced.
+func (array *SyncArray[T]) ToSpliced(start, deleteCount int, items ...T) []T {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.ToSpliced(start, deleteCount, items...)
+}
+
+// ToString returns a string representation of the array. See Array.ToString.
+func (array *SyncArray[T]) ToString() string {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.ToString()
+}
+
+// Unshift adds one or more elements to the beginning of the array and returns its new length. See Array.Unshift.
+func (array *SyncArray[T]) Unshift(elements ...T) int {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	return array.array.Unshift(elements...)
+}
+
+// SetEquality changes the equality function used to compare elements. See Array.SetEquality.
+func (array *SyncArray[T]) SetEquality(equal Equality[T]) *SyncArray[T] {
+	array.mu.Lock()
+	defer array.mu.Unlock()
+
+	array.array.SetEquality(equal)
+	return array
+}
+
+// Values returns an iterator over the elements of a snapshot of the array.
+// Unlike Array.Values, changes made to the SyncArray during iteration are not observed.
+func (array *SyncArray[T]) Values() iter.Seq[T] {
+	return array.Snapshot().Values()
+}
+
+// With returns a new array with the value at the given index replaced. See Array.With.
+func (array *SyncArray[T]) With(index int, value T) ([]T, error) {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.With(index, value)
+}
+
+// Difference returns a new array with the elements of the array that are not in the other array. See Array.Difference.
+func (array *SyncArray[T]) Difference(other *Array[T]) *Array[T] {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.Difference(other)
+}
+
+// Intersection returns a new array with the elements of the array that are also in the other array. See Array.Intersection.
+func (array *SyncArray[T]) Intersection(other *Array[T]) *Array[T] {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.Intersection(other)
+}
+
+// IsDisjointFrom reports whether the array has no elements in common with the other array. See Array.IsDisjointFrom.
+func (array *SyncArray[T]) IsDisjointFrom(other *Array[T]) bool {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.IsDisjointFrom(other)
+}
+
+// IsSubsetOf reports whether every element of the array is also in the other array. See Array.IsSubsetOf.
+func (array *SyncArray[T]) IsSubsetOf(other *Array[T]) bool {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.IsSubsetOf(other)
+}
+
+// IsSupersetOf reports whether every element of the other array is also in the array. See Array.IsSupersetOf.
+func (array *SyncArray[T]) IsSupersetOf(other *Array[T]) bool {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.IsSupersetOf(other)
+}
+
+// SymmetricDifference returns a new array with the elements that are in exactly one of the two arrays.
+// See Array.SymmetricDifference.
+func (array *SyncArray[T]) SymmetricDifference(other *Array[T]) *Array[T] {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.SymmetricDifference(other)
+}
+
+// Union returns a new array with the elements of both arrays, without duplicates. See Array.Union.
+func (array *SyncArray[T]) Union(other *Array[T]) *Array[T] {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.Union(other)
+}
+
+// Unique returns a new array with the duplicate elements of the array removed. See Array.Unique.
+func (array *SyncArray[T]) Unique() *Array[T] {
+	array.mu.RLock()
+	defer array.mu.RUnlock()
+
+	return array.array.Unique()
+}
+
+// clone returns a copy of the array that shares no elements storage with it.
+func (array *Array[T]) clone() *Array[T] {
+	return &Array[T]{
+		array: cloneSlice(array.array),
+		equal: array.equal,
+	}
+}
+
+// cloneSlice returns a copy of the given slice that is never nil.
+func cloneSlice[T any](arr []T) []T {
+	result := make([]T, len(arr))
+	copy(result, arr)
+
+	return result
+}
@@ -0,0 +1,198 @@
+package array
+
+import (
+	"sync"
+	"testing"
+)
+
+// These tests are meant to be run with -race, which reports any access to the shared elements made outside the lock.
+
+func TestSyncArrayConcurrentWriters(t *testing.T) {
+	const (
+		writers = 8
+		pushes  = 500
+	)
+
+	var (
+		arr = NewSync[int]()
+		wg  sync.WaitGroup
+	)
+
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < pushes; i++ {
+				arr.Push(i)
+				arr.Unshift(i)
+				arr.Pop()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got := arr.Length(); got != writers*pushes {
+		t.Errorf("Length() = %d, want %d", got, writers*pushes)
+	}
+}
+
+func TestSyncArrayConcurrentReadersAndWriters(t *testing.T) {
+	var (
+		arr  = NewSyncWithEntries([]int{1, 2, 3})
+		wg   sync.WaitGroup
+		stop = make(chan struct{})
+	)
+
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				arr.At(-1)
+				arr.Includes(2)
+				arr.Slice(1)
+				arr.Join(",")
+				for range arr.Values() {
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 200; i++ {
+		arr.Push(i)
+		arr.Splice(0, 1, i, i)
+		arr.Sort()
+		arr.Reverse()
+	}
+
+	close(stop)
+	wg.Wait()
+}
+
+func TestSyncArrayCallbacksRunAgainstSnapshot(t *testing.T) {
+	var (
+		arr = NewSyncWithEntries([]int{1, 2, 3})
+		wg  sync.WaitGroup
+	)
+
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				// Callbacks may call back into the SyncArray, including writes, without deadlocking,
+				// and the snapshot they were given does not change under them.
+				arr.ForEach(func(value, index int, snapshot *Array[int]) {
+					length := snapshot.Length()
+					arr.Push(value)
+					arr.Shift()
+
+					if snapshot.Length() != length {
+						t.Errorf("snapshot changed length from %d to %d during ForEach", length, snapshot.Length())
+					}
+				})
+
+				arr.Map(func(value, index int, snapshot *Array[int]) int {
+					return value + arr.Length()
+				})
+
+				arr.Filter(func(value, index int, snapshot *Array[int]) bool {
+					return arr.Includes(value)
+				})
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got := arr.Length(); got != 3 {
+		t.Errorf("Length() = %d, want 3", got)
+	}
+}
+
+func TestSyncArrayCompoundOperations(t *testing.T) {
+	var (
+		arr = NewSync[int]()
+		wg  sync.WaitGroup
+	)
+
+	added := make([]int, 8)
+	for g := range added {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				if arr.PushIfAbsent(i) {
+					added[g]++
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	total := 0
+	for _, n := range added {
+		total += n
+	}
+
+	if total != 100 || arr.Length() != 100 {
+		t.Errorf("PushIfAbsent added %d values leaving length %d, want 100", total, arr.Length())
+	}
+
+	counter := NewSyncWithEntries([]int{0})
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				for {
+					current := counter.At(0)
+					if counter.CompareAndSwapAt(0, current, current+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got := counter.At(0); got != 800 {
+		t.Errorf("counter = %d, want 800", got)
+	}
+}
+
+func TestPushReturnsLength(t *testing.T) {
+	tests := []struct {
+		name string
+		push func(value int) int
+	}{
+		{"Array", NewWithEntries([]int{1, 2}).Push},
+		{"SyncArray", NewSyncWithEntries([]int{1, 2}).Push},
+		{"ObservableArray", NewObservableWithEntries([]int{1, 2}).Push},
+		{"Deque", NewDequeWithEntries([]int{1, 2}).Push},
+	}
+
+	for _, tt := range tests {
+		if got := tt.push(3); got != 3 {
+			t.Errorf("%s.Push(3) = %d, want the new length 3", tt.name, got)
+		}
+
+		if got := tt.push(4); got != 4 {
+			t.Errorf("%s.Push(4) = %d, want the new length 4", tt.name, got)
+		}
+	}
+}
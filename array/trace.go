@@ -0,0 +1,170 @@
+package array
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// traceRate holds the bits of the global sample rate of tracing, which are zero while tracing is off.
+var traceRate atomic.Uint64
+
+// packagePath is the import path of this package, used to tell the callers of a traced method apart
+// from the methods of the package that call it.
+var packagePath = reflect.TypeFor[Policy]().PkgPath()
+
+// SetTracing sets the global sample rate of tracing and returns the previous rate. While tracing is on,
+// every call to a method of an Array is logged at the DEBUG level through the Logger set with SetLogger,
+// with its arguments, the length of the array afterwards, how long it took and where it was called from:
+//
+//	[DEBUG] Array.Push(4) length=4 duration=1.2µs caller=/app/main.go:12
+//
+// The rate is the fraction of calls to log, from 0, which turns tracing off, to 1, which logs every call.
+// Arrays whose rate was set with Array.SetTracing use their own rate instead. Only the outermost call is
+// logged: calls that a method of an Array makes to methods of any Array, the same one or another, are not.
+// While tracing is off, which it is by default, a traced method costs a nil check and a single atomic load.
+// It is safe to call at any time from any goroutine.
+//
+// Example:
+//
+//	array.SetTracing(0.01) // log one call in a hundred
+func SetTracing(rate float64) float64 {
+	return math.Float64frombits(traceRate.Swap(math.Float64bits(sampleRate(rate))))
+}
+
+// TracingRate returns the global sample rate of tracing, which is 0 while tracing is off.
+func TracingRate() float64 {
+	return math.Float64frombits(traceRate.Load())
+}
+
+// SetTracing sets the sample rate of tracing for the array alone, overriding the global rate set by the
+// package-level SetTracing, so that a single array can be traced, or left out of tracing. Passing a
+// negative rate makes the array follow the global rate again. It returns the array to allow chaining.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{1, 2, 3}).SetTracing(1)
+//	arr.Push(4) // logs "Array.Push(4)" with length=4, duration=1.2µs and caller=/app/main.go:12
+func (array *Array[T]) SetTracing(rate float64) *Array[T] {
+	if rate < 0 {
+		array.trace = nil
+		return array
+	}
+
+	rate = sampleRate(rate)
+	array.trace = &rate
+
+	return array
+}
+
+// tracing reports whether calls to the array may have to be traced, so that untraced arrays pay for
+// a nil check and an atomic load only.
+func (array *Array[T]) tracing() bool {
+	return array.trace != nil || traceRate.Load() != 0
+}
+
+// traceCall starts tracing a call to the method with the given arguments, and returns the function that
+// logs it once the method returns. It is meant to be deferred at the start of the method, behind a check
+// of tracing:
+//
+//	if array.tracing() {
+//		defer array.traceCall("Push", value)()
+//	}
+func (array *Array[T]) traceCall(method string, args ...any) func() {
+	rate := TracingRate()
+	if array.trace != nil {
+		rate = *array.trace
+	}
+
+	if rate <= 0 || (rate < 1 && rand.Float64() >= rate) {
+		return func() {}
+	}
+
+	caller, ok := tracedCaller()
+	if !ok {
+		return func() {}
+	}
+
+	// The arguments are formatted before the call, in case it changes them.
+	var (
+		call  = method + "(" + traceArgs(args) + ")"
+		start = time.Now()
+	)
+
+	return func() {
+		log(DEBUG, fmt.Sprintf("Array.%s length=%d duration=%s caller=%s", call, len(array.array), time.Since(start), caller))
+	}
+}
+
+// tracedCaller returns the location of the code that called the traced method, skipping wrappers from
+// this package such as SyncArray and CheckedArray. It reports false if the method was called by a method
+// of any Array, not only the same one, since the outermost call is traced instead.
+func tracedCaller() (string, bool) {
+	var pcs [32]uintptr
+
+	// Skip runtime.Callers, tracedCaller and traceCall, to start at the traced method.
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs[:])])
+	frames.Next()
+
+	frame, more := frames.Next()
+	if strings.HasPrefix(frame.Function, packagePath+".(*Array[") || strings.HasPrefix(frame.Function, packagePath+".Array[") {
+		return "", false
+	}
+
+	// The tests of this package call it from within, so their frames count as outside callers.
+	for more && strings.HasPrefix(frame.Function, packagePath+".") && !strings.HasSuffix(frame.File, "_test.go") {
+		frame, more = frames.Next()
+	}
+
+	return fmt.Sprintf("%s:%d", frame.File, frame.Line), true
+}
+
+// traceSpread marks the values of a variadic parameter, so that they are traced as separate arguments.
+type traceSpread []any
+
+// spread returns the values of a variadic parameter for traceCall.
+func spread[T any](values []T) traceSpread {
+	result := make(traceSpread, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+
+	return result
+}
+
+// traceArgs formats the arguments of a traced call on a single line.
+func traceArgs(args []any) string {
+	options := DefaultInspectOptions()
+	options.Depth = 1
+	options.MaxArrayLength = 10
+	options.BreakLength = Infinity
+
+	parts := []string{}
+	for _, arg := range args {
+		if values, ok := arg.(traceSpread); ok {
+			for _, v := range values {
+				parts = append(parts, Inspect(v, options))
+			}
+
+			continue
+		}
+
+		parts = append(parts, Inspect(arg, options))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// sampleRate clamps a sample rate to [0, 1], treating NaN as 0.
+func sampleRate(rate float64) float64 {
+	if !(rate > 0) {
+		return 0
+	}
+
+	return min(rate, 1)
+}
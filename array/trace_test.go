@@ -0,0 +1,71 @@
+package array
+
+import (
+	"strings"
+	"testing"
+)
+
+// captureLog runs fn with a Logger that collects the messages logged at the given level, one per line,
+// and returns them.
+func captureLog(t *testing.T, level int, fn func()) string {
+	t.Helper()
+
+	var logged strings.Builder
+
+	previous := SetLogger(func(l int, message string) {
+		if l != level {
+			t.Errorf("logged %q at level %d, want %d", message, l, level)
+		}
+
+		logged.WriteString(message + "\n")
+	})
+	defer SetLogger(previous)
+
+	fn()
+
+	return logged.String()
+}
+
+// captureTrace runs fn with every call traced, and returns the calls logged.
+func captureTrace(t *testing.T, fn func()) string {
+	t.Helper()
+
+	rate := SetTracing(1)
+	defer SetTracing(rate)
+
+	return captureLog(t, DEBUG, fn)
+}
+
+func TestTracingLogsOutermostCalls(t *testing.T) {
+	out := captureTrace(t, func() {
+		arr := NewWithEntries([]int{1, 2, 3})
+		arr.Push(4)
+		arr.Includes(2)
+	})
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("traced %d calls, want 2:\n%s", len(lines), out)
+	}
+
+	if !strings.Contains(lines[0], `Array.Push(4) length=4`) || !strings.Contains(lines[0], "trace_test.go") {
+		t.Errorf("Push traced as %q", lines[0])
+	}
+
+	// Includes calls IndexOf, which is not traced on its own.
+	if !strings.Contains(lines[1], "Array.Includes(2) ") {
+		t.Errorf("Includes traced as %q", lines[1])
+	}
+}
+
+func TestTracingOff(t *testing.T) {
+	out := captureTrace(t, func() {
+		SetTracing(0)
+		NewWithEntries([]int{1, 2, 3}).Push(4)
+		NewWithEntries([]int{1, 2, 3}).SetTracing(1).Push(4)
+	})
+
+	if strings.Count(out, "\n") != 1 {
+		t.Errorf("traced %q, want only the array with its own rate", out)
+	}
+}
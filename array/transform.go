@@ -0,0 +1,95 @@
+package array
+
+// Map creates a new array populated with the results of calling the provided function on every element of the given array.
+// Unlike the Map method, the callback may return a different type than the elements of the array.
+// The callback function takes the element value, its index and the array itself.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]int{1, 2, 3})
+//	strs := array.Map(arr, func(value int, index int, _ *array.Array[int]) string {
+//		return strconv.Itoa(value * 2)
+//	})
+//	// strs is now an array of type []string with elements "2", "4", "6"
+func Map[T, U any](array *Array[T], fn func(value T, index int, array *Array[T]) U) *Array[U] {
+	result := make([]U, 0, len(array.array))
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		result = append(result, fn(array.array[i], i, array))
+	}
+
+	return &Array[U]{
+		array: result,
+	}
+}
+
+// FlatMap calls the provided function on every element of the given array and flattens the returned slices
+// one level deep into a new array. The callback may return a different type than the elements of the array.
+// The callback function takes the element value, its index and the array itself.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]string{"a b", "c"})
+//	words := array.FlatMap(arr, func(value string, _ int, _ *array.Array[string]) []string {
+//		return strings.Fields(value)
+//	})
+//	// words is now an array of type []string with elements "a", "b", "c"
+func FlatMap[T, U any](array *Array[T], fn func(value T, index int, array *Array[T]) []U) *Array[U] {
+	result := []U{}
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		result = append(result, fn(array.array[i], i, array)...)
+	}
+
+	return &Array[U]{
+		array: result,
+	}
+}
+
+// Reduce executes the provided reducer function on each element of the given array (from left to right),
+// passing in the return value from the calculation on the preceding element.
+// The accumulator starts at the initial value and may be of a different type than the elements of the array.
+// If the array is empty, the initial value is returned.
+//
+// The callback function takes four arguments:
+//   - accumulator: The returned value of the previous callback, or the initial value.
+//   - value: The current element being processed in the array.
+//   - index: The index of the current element being processed in the array.
+//   - array: The array the element belongs to.
+//
+// Example:
+//
+//	arr := array.NewWithEntries([]string{"a", "bb", "ccc"})
+//	total := array.Reduce(arr, func(sum int, value string, _ int, _ *array.Array[string]) int {
+//		return sum + len(value)
+//	}, 0)
+//	// total is now 6
+func Reduce[T, A any](array *Array[T], fn func(accumulator A, value T, index int, array *Array[T]) A, initial A) A {
+	result := initial
+
+	for i, length := 0, len(array.array); i < length && i < len(array.array); i++ {
+		result = fn(result, array.array[i], i, array)
+	}
+
+	return result
+}
+
+// ReduceRight executes the provided reducer function on each element of the given array (from right to left),
+// passing in the return value from the calculation on the following element.
+// The accumulator starts at the initial value and may be of a different type than the elements of the array.
+// If the array is empty, the initial value is returned.
+//
+// The callback function takes the same four arguments as the callback of Reduce.
+func ReduceRight[T, A any](array *Array[T], fn func(accumulator A, value T, index int, array *Array[T]) A, initial A) A {
+	result := initial
+
+	for i := len(array.array) - 1; i >= 0; i-- {
+		if i >= len(array.array) {
+			continue
+		}
+
+		result = fn(result, array.array[i], i, array)
+	}
+
+	return result
+}
@@ -2,11 +2,14 @@ package array
 
 import (
 	"fmt"
-	"reflect"
+	"os"
+	"strings"
 
 	"github.com/iVitaliya/colors-go"
+	"github.com/iVitaliya/javascript-go/internal/bounds"
 )
 
+// The levels of the messages this package logs, passed to the Logger set with SetLogger.
 const (
 	_LOG = iota
 	INFO
@@ -17,75 +20,79 @@ const (
 
 const maxInt int = int(^uint(0) >> 1)
 
+// print writes the text to standard error behind a coloured tag for the given level, such as [DEBUG].
+// It is the Logger used until SetLogger installs another one. The tags are left uncoloured when
+// the terminal does not support colours.
 func print(state int, text ...string) {
 	var (
 		st    string
-		open  = colors.BrightBlack("[")
-		close = colors.BrightBlack("]")
+		open  = paint(colors.BrightBlack, "[")
+		close = paint(colors.BrightBlack, "]")
 	)
 
-	go func(_state int) {
-		switch _state {
-		case INFO:
-			st = open + colors.BrightBlue("INFO") + close
-			break
-		case DEBUG:
-			st = open + colors.Green("DEBUG") + close
-			break
-		case WARNING:
-			st = open + colors.Dim(colors.BrightYellow("WARNING")) + close
-			break
-		case ERROR:
-			st = open + colors.Red("ERROR") + close
-			break
-		}
-	}(state)
+	switch state {
+	case INFO:
+		st = open + paint(colors.BrightBlue, "INFO") + close
+	case DEBUG:
+		st = open + paint(colors.Green, "DEBUG") + close
+	case WARNING:
+		st = open + paint(colors.Dim, paint(colors.BrightYellow, "WARNING")) + close
+	case ERROR:
+		st = open + paint(colors.Red, "ERROR") + close
+	}
+
+	fmt.Fprintln(os.Stderr, st, strings.Join(text, " "))
 }
 
-func appendValue[T any](arr []T, value T) []T {
-	_arr := arr
+// spliceBounds resolves the start and deleteCount arguments of splice and toSpliced against an array
+// of the given length. The start is clamped like any relative index, and the delete count is clamped
+// to the range [0, length-start], so the two methods always agree on which elements are replaced.
+func spliceBounds(start, deleteCount, length int) (int, int) {
+	start = bounds.Clamp(start, length)
 
-	_arr = append(_arr, value)
+	if deleteCount < 0 {
+		deleteCount = 0
+	}
 
-	return _arr
+	if deleteCount > length-start {
+		deleteCount = length - start
+	}
+
+	return start, deleteCount
 }
 
-func flattenArray[T comparable](input interface{}, depth int) []T {
-	if depth <= 0 {
-		return []T{input.(T)}
-	}
+// spliceInto replaces deleteCount elements of arr starting at start with items, reusing the backing
+// array of arr when it has enough capacity. The bounds must already have been resolved by spliceBounds.
+func spliceInto[T any](arr []T, start, deleteCount int, items []T) []T {
+	var (
+		tail   = len(arr) - start - deleteCount
+		length = len(arr) - deleteCount + len(items)
+	)
 
-	v := reflect.ValueOf(input)
+	if length > cap(arr) {
+		result := make([]T, 0, length)
+		result = append(result, arr[:start]...)
+		result = append(result, items...)
 
-	if v.Kind() != reflect.Slice {
-		return []T{input.(T)}
+		return append(result, arr[start+deleteCount:]...)
 	}
 
-	var result []T
-
-	for i := 0; i < v.Len(); i++ {
-		elem := v.Index(i).Interface()
+	result := arr[:length]
+	copy(result[start+len(items):], arr[start+deleteCount:start+deleteCount+tail])
+	copy(result[start:], items)
 
-		if reflect.TypeOf(elem).Kind() == reflect.Slice {
-			result = append(result, flattenArray[T](elem, depth-1)...)
-		} else {
-			result = append(result, elem.(T))
-		}
+	var zero T
+	for i := length; i < len(arr); i++ {
+		arr[i] = zero
 	}
 
 	return result
 }
 
-func searchIndex[T any](arr []T, term T) int {
-	var trm string = fmt.Sprint(term)
-
-	for i, v := range arr {
-		val := fmt.Sprint(v)
+func appendValue[T any](arr []T, value T) []T {
+	_arr := arr
 
-		if trm == val {
-			return i
-		}
-	}
+	_arr = append(_arr, value)
 
-	return -1
+	return _arr
 }
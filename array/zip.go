@@ -0,0 +1,104 @@
+package array
+
+// Pair holds two values of possibly different types, such as the elements of two arrays zipped together.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types, such as the elements of three arrays zipped together.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Zip returns a new array pairing up the elements of the two arrays by index.
+// The result is as long as the shorter array; the extra elements of the longer array are dropped.
+//
+// Example:
+//
+//	names := array.NewWithEntries([]string{"a", "b", "c"})
+//	ages := array.NewWithEntries([]int{1, 2})
+//	array.Zip(names, ages) // [{a 1} {b 2}]
+func Zip[A, B any](a *Array[A], b *Array[B]) *Array[Pair[A, B]] {
+	return ZipWith(a, b, func(first A, second B, _ int) Pair[A, B] {
+		return Pair[A, B]{
+			First:  first,
+			Second: second,
+		}
+	})
+}
+
+// Zip3 returns a new array grouping the elements of the three arrays by index.
+// The result is as long as the shortest array.
+func Zip3[A, B, C any](a *Array[A], b *Array[B], c *Array[C]) *Array[Triple[A, B, C]] {
+	length := min(len(a.array), len(b.array), len(c.array))
+
+	result := make([]Triple[A, B, C], length)
+	for i := range result {
+		result[i] = Triple[A, B, C]{
+			First:  a.array[i],
+			Second: b.array[i],
+			Third:  c.array[i],
+		}
+	}
+
+	return &Array[Triple[A, B, C]]{
+		array: result,
+	}
+}
+
+// ZipWith returns a new array with the results of calling the provided function on the elements of the two
+// arrays at each index. The result is as long as the shorter array.
+// The callback function takes the element of each array and their index.
+//
+// Example:
+//
+//	prices := array.NewWithEntries([]float64{2.5, 4})
+//	counts := array.NewWithEntries([]int{2, 3})
+//	array.ZipWith(prices, counts, func(price float64, count int, _ int) float64 {
+//		return price * float64(count)
+//	}) // [5 12]
+func ZipWith[A, B, R any](a *Array[A], b *Array[B], fn func(first A, second B, index int) R) *Array[R] {
+	length := min(len(a.array), len(b.array))
+
+	result := make([]R, 0, length)
+	for i := 0; i < length && i < len(a.array) && i < len(b.array); i++ {
+		result = append(result, fn(a.array[i], b.array[i], i))
+	}
+
+	return &Array[R]{
+		array: result,
+	}
+}
+
+// Unzip splits an array of pairs into an array of their first values and an array of their second values,
+// undoing Zip.
+func Unzip[A, B any](pairs *Array[Pair[A, B]]) (*Array[A], *Array[B]) {
+	var (
+		first  = make([]A, len(pairs.array))
+		second = make([]B, len(pairs.array))
+	)
+
+	for i, pair := range pairs.array {
+		first[i], second[i] = pair.First, pair.Second
+	}
+
+	return &Array[A]{array: first}, &Array[B]{array: second}
+}
+
+// Unzip3 splits an array of triples into three arrays of their values, undoing Zip3.
+func Unzip3[A, B, C any](triples *Array[Triple[A, B, C]]) (*Array[A], *Array[B], *Array[C]) {
+	var (
+		first  = make([]A, len(triples.array))
+		second = make([]B, len(triples.array))
+		third  = make([]C, len(triples.array))
+	)
+
+	for i, triple := range triples.array {
+		first[i], second[i], third[i] = triple.First, triple.Second, triple.Third
+	}
+
+	return &Array[A]{array: first}, &Array[B]{array: second}, &Array[C]{array: third}
+}
@@ -0,0 +1,92 @@
+package array
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		a    []string
+		b    []int
+		want string
+	}{
+		{[]string{"a", "b", "c"}, []int{1, 2, 3}, "[{a 1} {b 2} {c 3}]"},
+		{[]string{"a", "b", "c"}, []int{1}, "[{a 1}]"},
+		{[]string{"a"}, []int{1, 2, 3}, "[{a 1}]"},
+		{nil, []int{1, 2}, "[]"},
+		{nil, nil, "[]"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprint(Zip(NewWithEntries(tt.a), NewWithEntries(tt.b)).array); got != tt.want {
+			t.Errorf("Zip(%v, %v) = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestZip3(t *testing.T) {
+	tests := []struct {
+		a    []int
+		b    []string
+		c    []bool
+		want string
+	}{
+		{[]int{1, 2}, []string{"a", "b"}, []bool{true, false}, "[{1 a true} {2 b false}]"},
+		{[]int{1, 2, 3}, []string{"a", "b"}, []bool{true, false, true}, "[{1 a true} {2 b false}]"},
+		{[]int{1, 2}, []string{"a", "b"}, []bool{true}, "[{1 a true}]"},
+		{[]int{1}, nil, []bool{true}, "[]"},
+	}
+
+	for _, tt := range tests {
+		got := Zip3(NewWithEntries(tt.a), NewWithEntries(tt.b), NewWithEntries(tt.c))
+		if fmt.Sprint(got.array) != tt.want {
+			t.Errorf("Zip3(%v, %v, %v) = %v, want %s", tt.a, tt.b, tt.c, got.array, tt.want)
+		}
+	}
+}
+
+func TestZipWith(t *testing.T) {
+	a := NewWithEntries([]int{1, 2, 3})
+	b := NewWithEntries([]int{10, 20})
+
+	got := ZipWith(a, b, func(first, second, index int) string {
+		return fmt.Sprint(index, ":", first+second)
+	})
+
+	if fmt.Sprint(got.array) != "[0:11 1:22]" {
+		t.Errorf("ZipWith() = %v, want [0:11 1:22]", got.array)
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	tests := []struct {
+		a     []string
+		b     []int
+		first string
+		other string
+	}{
+		{[]string{"a", "b"}, []int{1, 2}, "[a b]", "[1 2]"},
+		{[]string{"a", "b", "c"}, []int{1, 2}, "[a b]", "[1 2]"},
+		{[]string{"a"}, []int{1, 2, 3}, "[a]", "[1]"},
+		{nil, []int{1}, "[]", "[]"},
+	}
+
+	for _, tt := range tests {
+		first, other := Unzip(Zip(NewWithEntries(tt.a), NewWithEntries(tt.b)))
+
+		if fmt.Sprint(first.array) != tt.first || fmt.Sprint(other.array) != tt.other {
+			t.Errorf("Unzip(Zip(%v, %v)) = %v, %v, want %s, %s", tt.a, tt.b, first.array, other.array, tt.first, tt.other)
+		}
+	}
+
+	a, b, c := Unzip3(Zip3(NewWithEntries([]int{1, 2, 3}), NewWithEntries([]string{"x", "y"}), NewWithEntries([]bool{true, false, true})))
+	if got := fmt.Sprint(a.array, b.array, c.array); got != "[1 2] [x y] [true false]" {
+		t.Errorf("Unzip3(Zip3()) = %s, want [1 2] [x y] [true false]", got)
+	}
+
+	a, b, c = Unzip3(New[Triple[int, string, bool]]())
+	if a.Length()+b.Length()+c.Length() != 0 {
+		t.Error("Unzip3 of an empty array returned elements")
+	}
+}
@@ -1,13 +1,10 @@
 module github.com/iVitaliya/javascript-go
 
-go 1.20
+go 1.23
 
 require github.com/iVitaliya/colors-go v0.0.0-20220811123250-641c37bf0b3d // direct
 
-require (
-	github.com/GalacticDocs/store-go v0.0.0-20241213100046-176ae49060e0
-	github.com/iVitaliya/logger-go v0.0.0-20220817124746-eac18f71945e
-)
+require github.com/iVitaliya/logger-go v0.0.0-20220817124746-eac18f71945e
 
 require (
 	github.com/mattn/go-isatty v0.0.14 // indirect
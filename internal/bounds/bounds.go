@@ -0,0 +1,44 @@
+// Package bounds resolves the relative indices taken by the methods of arrays and typed arrays,
+// following the steps shared by the ECMAScript specification of Array.prototype and %TypedArray%.prototype.
+package bounds
+
+// Relative resolves an index that may be relative to the end of an array of the given length,
+// the way Array.prototype.at and Array.prototype.with do. Negative indices count back from the end.
+// The second return value reports whether the resolved index lies within the array.
+func Relative(index, length int) (int, bool) {
+	if index < 0 {
+		index += length
+	}
+
+	return index, index >= 0 && index < length
+}
+
+// Clamp resolves a relative start or end argument to a position in the range [0, length],
+// following the ToIntegerOrInfinity clamping steps shared by slice, fill, copyWithin and splice.
+// Negative indices count back from the end, and anything out of range is clamped instead of rejected.
+func Clamp(index, length int) int {
+	if index < 0 {
+		index += length
+		if index < 0 {
+			return 0
+		}
+
+		return index
+	}
+
+	if index > length {
+		return length
+	}
+
+	return index
+}
+
+// End resolves an optional end argument. When no end is given it defaults to the length of the
+// array, as an undefined end does in JavaScript; otherwise it is clamped like any other relative index.
+func End(end []int, length int) int {
+	if len(end) == 0 {
+		return length
+	}
+
+	return Clamp(end[0], length)
+}
@@ -0,0 +1,245 @@
+package iterator
+
+import (
+	"iter"
+
+	"github.com/iVitaliya/javascript-go/array"
+)
+
+// Iterator is a lazy sequence of values implementing the TC39 Iterator Helpers.
+// Helpers such as Filter, Take and Map wrap the iterator they are called on without consuming it,
+// so a pipeline only pulls values through when it is iterated or when a terminal method such as
+// ToArray, Reduce or ForEach is called. No intermediate slices are allocated along the way.
+//
+// An Iterator is a range-over-func sequence, so it can also be used directly in a for loop:
+//
+//	for v := range iterator.FromArray(arr).Filter(isEven).Take(10) {
+//		fmt.Println(v)
+//	}
+type Iterator[T any] iter.Seq[T]
+
+// From wraps the given sequence in an Iterator, the equivalent of Iterator.from.
+//
+// Example:
+//
+//	it := iterator.From(maps.Keys(m))
+func From[T any](seq iter.Seq[T]) Iterator[T] {
+	return Iterator[T](seq)
+}
+
+// FromSlice returns an Iterator over the elements of the given slice.
+func FromSlice[T any](values []T) Iterator[T] {
+	return func(yield func(T) bool) {
+		for _, v := range values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromArray returns an Iterator over the elements of the given array.
+// Like the iterator returned by Array.prototype.values, it reads the array lazily as it is iterated.
+func FromArray[T any](arr *array.Array[T]) Iterator[T] {
+	return Iterator[T](arr.Values())
+}
+
+// Concat returns an Iterator yielding the values of each of the given iterators in turn,
+// the equivalent of Iterator.concat.
+func Concat[T any](iterators ...Iterator[T]) Iterator[T] {
+	return func(yield func(T) bool) {
+		for _, it := range iterators {
+			for v := range it {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Map returns an Iterator yielding the results of calling the provided function on each value of the given iterator.
+// The callback function takes the value and a counter of the values seen so far, starting at 0.
+// It is a package-level function because the result may be of a different type than the values of the iterator.
+func Map[T, U any](it Iterator[T], fn func(value T, counter int) U) Iterator[U] {
+	return func(yield func(U) bool) {
+		counter := 0
+
+		for v := range it {
+			if !yield(fn(v, counter)) {
+				return
+			}
+
+			counter++
+		}
+	}
+}
+
+// FlatMap returns an Iterator yielding the values of each sequence returned by the provided function,
+// flattened one level deep.
+// The callback function takes the value and a counter of the values seen so far, starting at 0.
+func FlatMap[T, U any](it Iterator[T], fn func(value T, counter int) iter.Seq[U]) Iterator[U] {
+	return func(yield func(U) bool) {
+		counter := 0
+
+		for v := range it {
+			for inner := range fn(v, counter) {
+				if !yield(inner) {
+					return
+				}
+			}
+
+			counter++
+		}
+	}
+}
+
+// Reduce consumes the given iterator, calling the provided reducer function on each value and
+// passing in the return value from the calculation on the preceding value.
+// The accumulator starts at the initial value, which is returned if the iterator is empty.
+func Reduce[T, A any](it Iterator[T], fn func(accumulator A, value T, counter int) A, initial A) A {
+	var (
+		result  = initial
+		counter = 0
+	)
+
+	for v := range it {
+		result = fn(result, v, counter)
+		counter++
+	}
+
+	return result
+}
+
+// Filter returns an Iterator yielding only the values that pass the test implemented by the provided function.
+// The callback function takes the value and a counter of the values seen so far, starting at 0.
+func (it Iterator[T]) Filter(fn func(value T, counter int) bool) Iterator[T] {
+	return func(yield func(T) bool) {
+		counter := 0
+
+		for v := range it {
+			if fn(v, counter) && !yield(v) {
+				return
+			}
+
+			counter++
+		}
+	}
+}
+
+// Take returns an Iterator yielding at most limit values of the iterator, after which it stops
+// pulling from the iterator. A negative limit is treated as 0.
+func (it Iterator[T]) Take(limit int) Iterator[T] {
+	return func(yield func(T) bool) {
+		if limit <= 0 {
+			return
+		}
+
+		remaining := limit
+
+		for v := range it {
+			if !yield(v) {
+				return
+			}
+
+			remaining--
+			if remaining == 0 {
+				return
+			}
+		}
+	}
+}
+
+// Drop returns an Iterator skipping the first limit values of the iterator and yielding the rest.
+// A negative limit is treated as 0.
+func (it Iterator[T]) Drop(limit int) Iterator[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+
+		for v := range it {
+			if skipped < limit {
+				skipped++
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ForEach consumes the iterator, calling the provided function once for each value.
+// The callback function takes the value and a counter of the values seen so far, starting at 0.
+func (it Iterator[T]) ForEach(fn func(value T, counter int)) {
+	counter := 0
+
+	for v := range it {
+		fn(v, counter)
+		counter++
+	}
+}
+
+// Some tests whether at least one value of the iterator passes the test implemented by the provided function.
+// It stops pulling from the iterator as soon as a value passes the test.
+func (it Iterator[T]) Some(fn func(value T, counter int) bool) bool {
+	counter := 0
+
+	for v := range it {
+		if fn(v, counter) {
+			return true
+		}
+
+		counter++
+	}
+
+	return false
+}
+
+// Every tests whether all values of the iterator pass the test implemented by the provided function.
+// It stops pulling from the iterator as soon as a value fails the test, and returns true for an empty iterator.
+func (it Iterator[T]) Every(fn func(value T, counter int) bool) bool {
+	counter := 0
+
+	for v := range it {
+		if !fn(v, counter) {
+			return false
+		}
+
+		counter++
+	}
+
+	return true
+}
+
+// Find returns the first value of the iterator that satisfies the provided testing function, and true if found.
+// If no value is found, it returns a zero value of type T and false.
+func (it Iterator[T]) Find(fn func(value T, counter int) bool) (T, bool) {
+	counter := 0
+
+	for v := range it {
+		if fn(v, counter) {
+			return v, true
+		}
+
+		counter++
+	}
+
+	return *new(T), false
+}
+
+// ToArray consumes the iterator and collects its values into a new array.
+func (it Iterator[T]) ToArray() *array.Array[T] {
+	arr := array.New[T]()
+
+	for v := range it {
+		arr.Push(v)
+	}
+
+	return arr
+}
+
+// Seq returns the iterator as a plain iter.Seq, for use with functions from the standard library.
+func (it Iterator[T]) Seq() iter.Seq[T] {
+	return iter.Seq[T](it)
+}
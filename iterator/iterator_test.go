@@ -0,0 +1,175 @@
+package iterator
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/iVitaliya/javascript-go/array"
+)
+
+// naturals returns an endless Iterator over 0, 1, 2, ... that counts in pulls how many values were taken from it.
+func naturals(pulls *int) Iterator[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*pulls++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// collect consumes the iterator into a slice.
+func collect[T any](it Iterator[T]) []T {
+	return slices.Collect(it.Seq())
+}
+
+func isEven(value, counter int) bool { return value%2 == 0 }
+
+func TestLaziness(t *testing.T) {
+	var pulls int
+
+	it := Map(naturals(&pulls).Filter(isEven).Drop(1), func(value, counter int) int { return value * 10 })
+	it = FlatMap(it, func(value, counter int) iter.Seq[int] { return FromSlice([]int{value, -value}).Seq() })
+	it = Concat(it, naturals(&pulls))
+
+	if pulls != 0 {
+		t.Fatalf("building a pipeline pulled %d values, want 0", pulls)
+	}
+
+	if got, want := collect(it.Take(3)), []int{20, -20, 40}; !slices.Equal(got, want) {
+		t.Errorf("Take(3) = %v, want %v", got, want)
+	}
+
+	if pulls != 5 {
+		t.Errorf("Take(3) pulled %d values, want 5 (0 dropped, 1 filtered, 2, 3 filtered, 4)", pulls)
+	}
+}
+
+func TestEarlyStop(t *testing.T) {
+	tests := []struct {
+		name  string
+		run   func(it Iterator[int]) any
+		want  any
+		pulls int
+	}{
+		{"Take", func(it Iterator[int]) any { return len(collect(it.Take(4))) }, 4, 4},
+		{"Take(0)", func(it Iterator[int]) any { return len(collect(it.Take(0))) }, 0, 0},
+		{"Take(-1)", func(it Iterator[int]) any { return len(collect(it.Take(-1))) }, 0, 0},
+		{"Drop then Take", func(it Iterator[int]) any { return collect(it.Drop(3).Take(2))[0] }, 3, 5},
+		{"Drop(-1)", func(it Iterator[int]) any { return collect(it.Drop(-1).Take(1))[0] }, 0, 1},
+		{"Filter then Take", func(it Iterator[int]) any { return collect(it.Filter(isEven).Take(3))[2] }, 4, 5},
+		{"Map then Take", func(it Iterator[int]) any {
+			return collect(Map(it, func(value, counter int) int { return value + counter }).Take(3))[2]
+		}, 4, 3},
+		{"FlatMap stops inside an inner sequence", func(it Iterator[int]) any {
+			inner := 0
+			flat := FlatMap(it, func(value, counter int) iter.Seq[int] {
+				return naturals(&inner).Take(3).Seq()
+			})
+			return []int{len(collect(flat.Take(4))), inner}
+		}, []int{4, 4}, 2},
+		{"Concat does not start later iterators", func(it Iterator[int]) any {
+			later := 0
+			return []int{len(collect(Concat(it.Take(2), naturals(&later)).Take(2))), later}
+		}, []int{2, 0}, 2},
+		{"Some", func(it Iterator[int]) any { return it.Some(func(value, counter int) bool { return value == 6 }) }, true, 7},
+		{"Every", func(it Iterator[int]) any { return it.Every(func(value, counter int) bool { return value < 3 }) }, false, 4},
+		{"Find", func(it Iterator[int]) any {
+			v, ok := it.Find(func(value, counter int) bool { return value > 1 })
+			return []any{v, ok}
+		}, []any{2, true}, 3},
+		{"range with break", func(it Iterator[int]) any {
+			for v := range it {
+				if v == 2 {
+					return v
+				}
+			}
+			return -1
+		}, 2, 3},
+	}
+
+	for _, tt := range tests {
+		var pulls int
+
+		got := tt.run(naturals(&pulls))
+		if !equalAny(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+
+		if pulls != tt.pulls {
+			t.Errorf("%s pulled %d values, want %d", tt.name, pulls, tt.pulls)
+		}
+	}
+}
+
+// equalAny compares ints, bools and slices of either.
+func equalAny(a, b any) bool {
+	switch a := a.(type) {
+	case []int:
+		return slices.Equal(a, b.([]int))
+	case []any:
+		return slices.Equal(a, b.([]any))
+	}
+
+	return a == b
+}
+
+func TestCounters(t *testing.T) {
+	var counters []int
+
+	Map(FromSlice([]string{"a", "b", "c"}), func(value string, counter int) string {
+		counters = append(counters, counter)
+		return value
+	}).Drop(1).ForEach(func(value string, counter int) {
+		counters = append(counters, counter)
+	})
+
+	// Map sees every value; ForEach only sees those left after Drop.
+	if want := []int{0, 1, 0, 2, 1}; !slices.Equal(counters, want) {
+		t.Errorf("counters = %v, want %v", counters, want)
+	}
+
+	sum := Reduce(FromSlice([]int{1, 2, 3}), func(accumulator string, value, counter int) string {
+		return accumulator + string(rune('0'+counter))
+	}, "")
+
+	if sum != "012" {
+		t.Errorf("Reduce counters = %q, want \"012\"", sum)
+	}
+
+	if got := Reduce(FromSlice([]int{}), func(accumulator, value, counter int) int { return 0 }, 42); got != 42 {
+		t.Errorf("Reduce of an empty iterator = %d, want the initial value 42", got)
+	}
+}
+
+func TestArrayInterop(t *testing.T) {
+	arr := array.NewWithEntries([]int{1, 2, 3})
+
+	// FromArray reads the array as it is iterated, like Array.prototype.values.
+	seen := []int{}
+	for v := range FromArray(arr) {
+		seen = append(seen, v)
+		if v == 1 {
+			arr.Push(4)
+		}
+	}
+
+	if want := []int{1, 2, 3, 4}; !slices.Equal(seen, want) {
+		t.Errorf("FromArray saw %v, want the pushed element too: %v", seen, want)
+	}
+
+	doubled := Map(FromArray(arr), func(value, counter int) int { return value * 2 }).ToArray()
+	if got, want := doubled.Slice(0), []int{2, 4, 6, 8}; !slices.Equal(got, want) {
+		t.Errorf("ToArray() = %v, want %v", got, want)
+	}
+
+	if got := FromSlice([]int{}).ToArray(); got.Length() != 0 {
+		t.Errorf("ToArray() of an empty iterator has length %d", got.Length())
+	}
+
+	if got := collect(From(arr.Values()).Filter(isEven)); !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("From(arr.Values()).Filter() = %v, want [2 4]", got)
+	}
+}
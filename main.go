@@ -1 +1,3 @@
-package main
\ No newline at end of file
+package main
+
+func main() {}
@@ -0,0 +1,65 @@
+package typedarray
+
+import "github.com/iVitaliya/javascript-go/internal/bounds"
+
+// ArrayBuffer is a fixed-length block of raw binary data, the equivalent of the JavaScript ArrayBuffer.
+// Its contents cannot be read or written directly; instead, one or more typed arrays or data views
+// are created over it, and all views over the same buffer share its memory.
+//
+// Example:
+//
+//	buf := typedarray.NewArrayBuffer(8)
+//	bytes, _ := typedarray.NewUint8ArrayView(buf, 0)
+//	words, _ := typedarray.NewUint32ArrayView(buf, 0)
+//	words.Set(0, 0xFFFFFFFF)
+//	bytes.At(0) // 255
+type ArrayBuffer struct {
+	data []byte
+}
+
+// NewArrayBuffer returns a new buffer of the given length in bytes, with every byte set to zero.
+// A negative length is treated as 0.
+func NewArrayBuffer(byteLength int) *ArrayBuffer {
+	if byteLength < 0 {
+		byteLength = 0
+	}
+
+	return &ArrayBuffer{
+		data: make([]byte, byteLength),
+	}
+}
+
+// ArrayBufferFrom returns a new buffer holding a copy of the given bytes.
+func ArrayBufferFrom(data []byte) *ArrayBuffer {
+	buf := NewArrayBuffer(len(data))
+	copy(buf.data, data)
+
+	return buf
+}
+
+// ByteLength returns the length of the buffer in bytes.
+func (buffer *ArrayBuffer) ByteLength() int {
+	return len(buffer.data)
+}
+
+// Bytes returns the contents of the buffer.
+// The returned slice is a view over the same memory as the buffer, so modifying it modifies every view over the buffer.
+func (buffer *ArrayBuffer) Bytes() []byte {
+	return buffer.data
+}
+
+// Slice returns a new buffer holding a copy of the bytes from the start index to the end index (exclusive).
+// Negative indices are treated as offsets from the end of the buffer, and indices out of range are
+// clamped to the bounds of the buffer. If no end index is given, the copy extends to the end of the buffer.
+func (buffer *ArrayBuffer) Slice(start int, end ...int) *ArrayBuffer {
+	var (
+		from  = bounds.Clamp(start, len(buffer.data))
+		final = bounds.End(end, len(buffer.data))
+	)
+
+	if final < from {
+		final = from
+	}
+
+	return ArrayBufferFrom(buffer.data[from:final])
+}
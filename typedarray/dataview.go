@@ -0,0 +1,240 @@
+package typedarray
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// DataView reads and writes numbers of any size at any byte offset of an ArrayBuffer, with an explicit byte order.
+// It is the equivalent of the JavaScript DataView: every getter and setter takes an optional littleEndian flag,
+// and reads and writes big-endian when the flag is omitted or false.
+//
+// Example:
+//
+//	view, _ := typedarray.NewDataView(buf, 0)
+//	length, _ := view.GetUint16(0)        // big-endian
+//	crc, _ := view.GetUint32(2, true)     // little-endian
+type DataView struct {
+	buffer     *ArrayBuffer
+	byteOffset int
+	byteLength int
+}
+
+// NewDataView returns a DataView over the given buffer, starting at byteOffset.
+// If no byte length is given, the view extends to the end of the buffer.
+// It returns ErrOutOfRange if the view would reach outside the buffer.
+func NewDataView(buffer *ArrayBuffer, byteOffset int, byteLength ...int) (*DataView, error) {
+	if byteOffset < 0 || byteOffset > buffer.ByteLength() {
+		return nil, ErrOutOfRange
+	}
+
+	length := buffer.ByteLength() - byteOffset
+	if len(byteLength) > 0 {
+		length = byteLength[0]
+	}
+
+	if length < 0 || byteOffset+length > buffer.ByteLength() {
+		return nil, ErrOutOfRange
+	}
+
+	return &DataView{
+		buffer:     buffer,
+		byteOffset: byteOffset,
+		byteLength: length,
+	}, nil
+}
+
+// Buffer returns the buffer the view reads from and writes to.
+func (view *DataView) Buffer() *ArrayBuffer {
+	return view.buffer
+}
+
+// ByteOffset returns the offset in bytes of the view from the start of its buffer.
+func (view *DataView) ByteOffset() int {
+	return view.byteOffset
+}
+
+// ByteLength returns the length in bytes of the view.
+func (view *DataView) ByteLength() int {
+	return view.byteLength
+}
+
+// GetInt8 reads a signed 8-bit integer at the given byte offset of the view.
+func (view *DataView) GetInt8(byteOffset int) (int8, error) {
+	b, err := view.bytes(byteOffset, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return int8(b[0]), nil
+}
+
+// GetUint8 reads an unsigned 8-bit integer at the given byte offset of the view.
+func (view *DataView) GetUint8(byteOffset int) (uint8, error) {
+	b, err := view.bytes(byteOffset, 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return b[0], nil
+}
+
+// GetInt16 reads a signed 16-bit integer at the given byte offset of the view.
+func (view *DataView) GetInt16(byteOffset int, littleEndian ...bool) (int16, error) {
+	v, err := view.GetUint16(byteOffset, littleEndian...)
+
+	return int16(v), err
+}
+
+// GetUint16 reads an unsigned 16-bit integer at the given byte offset of the view.
+func (view *DataView) GetUint16(byteOffset int, littleEndian ...bool) (uint16, error) {
+	b, err := view.bytes(byteOffset, 2)
+	if err != nil {
+		return 0, err
+	}
+
+	return byteOrder(littleEndian).Uint16(b), nil
+}
+
+// GetInt32 reads a signed 32-bit integer at the given byte offset of the view.
+func (view *DataView) GetInt32(byteOffset int, littleEndian ...bool) (int32, error) {
+	v, err := view.GetUint32(byteOffset, littleEndian...)
+
+	return int32(v), err
+}
+
+// GetUint32 reads an unsigned 32-bit integer at the given byte offset of the view.
+func (view *DataView) GetUint32(byteOffset int, littleEndian ...bool) (uint32, error) {
+	b, err := view.bytes(byteOffset, 4)
+	if err != nil {
+		return 0, err
+	}
+
+	return byteOrder(littleEndian).Uint32(b), nil
+}
+
+// GetBigInt64 reads a signed 64-bit integer at the given byte offset of the view.
+func (view *DataView) GetBigInt64(byteOffset int, littleEndian ...bool) (int64, error) {
+	v, err := view.GetBigUint64(byteOffset, littleEndian...)
+
+	return int64(v), err
+}
+
+// GetBigUint64 reads an unsigned 64-bit integer at the given byte offset of the view.
+func (view *DataView) GetBigUint64(byteOffset int, littleEndian ...bool) (uint64, error) {
+	b, err := view.bytes(byteOffset, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	return byteOrder(littleEndian).Uint64(b), nil
+}
+
+// GetFloat32 reads a 32-bit floating-point number at the given byte offset of the view.
+func (view *DataView) GetFloat32(byteOffset int, littleEndian ...bool) (float32, error) {
+	v, err := view.GetUint32(byteOffset, littleEndian...)
+
+	return math.Float32frombits(v), err
+}
+
+// GetFloat64 reads a 64-bit floating-point number at the given byte offset of the view.
+func (view *DataView) GetFloat64(byteOffset int, littleEndian ...bool) (float64, error) {
+	v, err := view.GetBigUint64(byteOffset, littleEndian...)
+
+	return math.Float64frombits(v), err
+}
+
+// SetInt8 writes a signed 8-bit integer at the given byte offset of the view.
+func (view *DataView) SetInt8(byteOffset int, value int8) error {
+	return view.SetUint8(byteOffset, uint8(value))
+}
+
+// SetUint8 writes an unsigned 8-bit integer at the given byte offset of the view.
+func (view *DataView) SetUint8(byteOffset int, value uint8) error {
+	b, err := view.bytes(byteOffset, 1)
+	if err != nil {
+		return err
+	}
+
+	b[0] = value
+	return nil
+}
+
+// SetInt16 writes a signed 16-bit integer at the given byte offset of the view.
+func (view *DataView) SetInt16(byteOffset int, value int16, littleEndian ...bool) error {
+	return view.SetUint16(byteOffset, uint16(value), littleEndian...)
+}
+
+// SetUint16 writes an unsigned 16-bit integer at the given byte offset of the view.
+func (view *DataView) SetUint16(byteOffset int, value uint16, littleEndian ...bool) error {
+	b, err := view.bytes(byteOffset, 2)
+	if err != nil {
+		return err
+	}
+
+	byteOrder(littleEndian).PutUint16(b, value)
+	return nil
+}
+
+// SetInt32 writes a signed 32-bit integer at the given byte offset of the view.
+func (view *DataView) SetInt32(byteOffset int, value int32, littleEndian ...bool) error {
+	return view.SetUint32(byteOffset, uint32(value), littleEndian...)
+}
+
+// SetUint32 writes an unsigned 32-bit integer at the given byte offset of the view.
+func (view *DataView) SetUint32(byteOffset int, value uint32, littleEndian ...bool) error {
+	b, err := view.bytes(byteOffset, 4)
+	if err != nil {
+		return err
+	}
+
+	byteOrder(littleEndian).PutUint32(b, value)
+	return nil
+}
+
+// SetBigInt64 writes a signed 64-bit integer at the given byte offset of the view.
+func (view *DataView) SetBigInt64(byteOffset int, value int64, littleEndian ...bool) error {
+	return view.SetBigUint64(byteOffset, uint64(value), littleEndian...)
+}
+
+// SetBigUint64 writes an unsigned 64-bit integer at the given byte offset of the view.
+func (view *DataView) SetBigUint64(byteOffset int, value uint64, littleEndian ...bool) error {
+	b, err := view.bytes(byteOffset, 8)
+	if err != nil {
+		return err
+	}
+
+	byteOrder(littleEndian).PutUint64(b, value)
+	return nil
+}
+
+// SetFloat32 writes a 32-bit floating-point number at the given byte offset of the view.
+func (view *DataView) SetFloat32(byteOffset int, value float32, littleEndian ...bool) error {
+	return view.SetUint32(byteOffset, math.Float32bits(value), littleEndian...)
+}
+
+// SetFloat64 writes a 64-bit floating-point number at the given byte offset of the view.
+func (view *DataView) SetFloat64(byteOffset int, value float64, littleEndian ...bool) error {
+	return view.SetBigUint64(byteOffset, math.Float64bits(value), littleEndian...)
+}
+
+// bytes returns the size bytes of the buffer at the given byte offset of the view,
+// or ErrOutOfRange if they do not all lie within the view.
+func (view *DataView) bytes(byteOffset, size int) ([]byte, error) {
+	if byteOffset < 0 || byteOffset > view.byteLength-size {
+		return nil, ErrOutOfRange
+	}
+
+	offset := view.byteOffset + byteOffset
+
+	return view.buffer.data[offset : offset+size], nil
+}
+
+// byteOrder returns the byte order selected by the optional littleEndian flag, defaulting to big-endian.
+func byteOrder(littleEndian []bool) binary.ByteOrder {
+	if len(littleEndian) > 0 && littleEndian[0] {
+		return binary.LittleEndian
+	}
+
+	return binary.BigEndian
+}
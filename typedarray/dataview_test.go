@@ -0,0 +1,228 @@
+package typedarray
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+// accessor writes and reads one kind of number through a DataView, in the byte order given by the flag.
+type accessor struct {
+	name  string
+	size  int
+	value any
+	big   []byte // the bytes of value in big-endian order
+	set   func(view *DataView, offset int, littleEndian ...bool) error
+	get   func(view *DataView, offset int, littleEndian ...bool) (any, error)
+}
+
+// accessors returns an accessor for every getter and setter pair of DataView.
+func accessors() []accessor {
+	return []accessor{
+		{"Int8", 1, int8(-2), []byte{0xfe},
+			func(view *DataView, offset int, _ ...bool) error { return view.SetInt8(offset, -2) },
+			func(view *DataView, offset int, _ ...bool) (any, error) { return view.GetInt8(offset) }},
+		{"Uint8", 1, uint8(0xab), []byte{0xab},
+			func(view *DataView, offset int, _ ...bool) error { return view.SetUint8(offset, 0xab) },
+			func(view *DataView, offset int, _ ...bool) (any, error) { return view.GetUint8(offset) }},
+		{"Int16", 2, int16(-2), []byte{0xff, 0xfe},
+			func(view *DataView, offset int, le ...bool) error { return view.SetInt16(offset, -2, le...) },
+			func(view *DataView, offset int, le ...bool) (any, error) { return view.GetInt16(offset, le...) }},
+		{"Uint16", 2, uint16(0x1234), []byte{0x12, 0x34},
+			func(view *DataView, offset int, le ...bool) error { return view.SetUint16(offset, 0x1234, le...) },
+			func(view *DataView, offset int, le ...bool) (any, error) { return view.GetUint16(offset, le...) }},
+		{"Int32", 4, int32(-123456789), []byte{0xf8, 0xa4, 0x32, 0xeb},
+			func(view *DataView, offset int, le ...bool) error { return view.SetInt32(offset, -123456789, le...) },
+			func(view *DataView, offset int, le ...bool) (any, error) { return view.GetInt32(offset, le...) }},
+		{"Uint32", 4, uint32(0xdeadbeef), []byte{0xde, 0xad, 0xbe, 0xef},
+			func(view *DataView, offset int, le ...bool) error { return view.SetUint32(offset, 0xdeadbeef, le...) },
+			func(view *DataView, offset int, le ...bool) (any, error) { return view.GetUint32(offset, le...) }},
+		{"BigInt64", 8, int64(-2), []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe},
+			func(view *DataView, offset int, le ...bool) error { return view.SetBigInt64(offset, -2, le...) },
+			func(view *DataView, offset int, le ...bool) (any, error) { return view.GetBigInt64(offset, le...) }},
+		{"BigUint64", 8, uint64(0x0102030405060708), []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			func(view *DataView, offset int, le ...bool) error {
+				return view.SetBigUint64(offset, 0x0102030405060708, le...)
+			},
+			func(view *DataView, offset int, le ...bool) (any, error) { return view.GetBigUint64(offset, le...) }},
+		{"Float32", 4, float32(1.5), []byte{0x3f, 0xc0, 0, 0},
+			func(view *DataView, offset int, le ...bool) error { return view.SetFloat32(offset, 1.5, le...) },
+			func(view *DataView, offset int, le ...bool) (any, error) { return view.GetFloat32(offset, le...) }},
+		{"Float64", 8, -2.5, []byte{0xc0, 0x04, 0, 0, 0, 0, 0, 0},
+			func(view *DataView, offset int, le ...bool) error { return view.SetFloat64(offset, -2.5, le...) },
+			func(view *DataView, offset int, le ...bool) (any, error) { return view.GetFloat64(offset, le...) }},
+	}
+}
+
+func TestDataViewByteOrder(t *testing.T) {
+	orders := []struct {
+		name  string
+		flags []bool
+		flip  bool
+	}{
+		{"default", nil, false},
+		{"big-endian", []bool{false}, false},
+		{"little-endian", []bool{true}, true},
+	}
+
+	for _, a := range accessors() {
+		for _, order := range orders {
+			want := slices.Clone(a.big)
+			if order.flip {
+				slices.Reverse(want)
+			}
+
+			// The view starts one byte into the buffer and is written one byte into the view,
+			// so that the offsets of both are applied.
+			buf := NewArrayBuffer(a.size + 3)
+			view, _ := NewDataView(buf, 1, a.size+1)
+
+			if err := a.set(view, 1, order.flags...); err != nil {
+				t.Errorf("%s %s: Set returned %v", a.name, order.name, err)
+				continue
+			}
+
+			if got := buf.Bytes()[2 : 2+a.size]; !bytes.Equal(got, want) {
+				t.Errorf("%s %s: Set wrote % x, want % x", a.name, order.name, got, want)
+			}
+
+			if got, err := a.get(view, 1, order.flags...); err != nil || got != a.value {
+				t.Errorf("%s %s: Get = %v, %v, want %v", a.name, order.name, got, err, a.value)
+			}
+
+			if buf.Bytes()[0] != 0 || buf.Bytes()[1] != 0 || buf.Bytes()[a.size+2] != 0 {
+				t.Errorf("%s %s: Set wrote outside its bytes: % x", a.name, order.name, buf.Bytes())
+			}
+		}
+	}
+}
+
+func TestDataViewMixedByteOrder(t *testing.T) {
+	view, _ := NewDataView(NewArrayBuffer(8), 0)
+
+	tests := []struct {
+		name string
+		run  func() (any, error)
+		want any
+	}{
+		{"Uint16", func() (any, error) {
+			view.SetUint16(0, 0x1234, true)
+			return view.GetUint16(0)
+		}, uint16(0x3412)},
+		{"Uint32", func() (any, error) {
+			view.SetUint32(0, 0x12345678)
+			return view.GetUint32(0, true)
+		}, uint32(0x78563412)},
+		{"BigUint64", func() (any, error) {
+			view.SetBigUint64(0, 1, true)
+			return view.GetBigUint64(0)
+		}, uint64(1) << 56},
+		{"Int16 across Uint8", func() (any, error) {
+			view.SetUint8(0, 0x80)
+			view.SetUint8(1, 0x01)
+			return view.GetInt16(0)
+		}, int16(-32767)},
+	}
+
+	for _, tt := range tests {
+		if got, err := tt.run(); err != nil || got != tt.want {
+			t.Errorf("%s = %v, %v, want %v", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestDataViewFloats(t *testing.T) {
+	view, _ := NewDataView(NewArrayBuffer(8), 0)
+
+	for _, le := range []bool{false, true} {
+		for _, v := range []float64{math.NaN(), math.Inf(-1), math.Copysign(0, -1), math.SmallestNonzeroFloat64} {
+			view.SetFloat64(0, v, le)
+			got, _ := view.GetFloat64(0, le)
+
+			if math.Float64bits(got) != math.Float64bits(v) {
+				t.Errorf("Float64 %v with littleEndian %t read back as %v", v, le, got)
+			}
+		}
+
+		// Float32 keeps the sign of zero and NaN as well.
+		view.SetFloat32(0, float32(math.Copysign(0, -1)), le)
+		if got, _ := view.GetFloat32(0, le); !math.Signbit(float64(got)) {
+			t.Errorf("Float32 -0 with littleEndian %t read back as %v", le, got)
+		}
+
+		view.SetFloat32(0, float32(math.NaN()), le)
+		if got, _ := view.GetFloat32(0, le); got == got {
+			t.Errorf("Float32 NaN with littleEndian %t read back as %v", le, got)
+		}
+	}
+}
+
+func TestDataViewBounds(t *testing.T) {
+	buf := NewArrayBuffer(10)
+	view, _ := NewDataView(buf, 2, 6)
+
+	for _, a := range accessors() {
+		for _, offset := range []int{-1, view.ByteLength() - a.size + 1, view.ByteLength(), math.MaxInt} {
+			if err := a.set(view, offset); !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("%s Set at %d returned %v, want ErrOutOfRange", a.name, offset, err)
+			}
+
+			if _, err := a.get(view, offset); !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("%s Get at %d returned %v, want ErrOutOfRange", a.name, offset, err)
+			}
+		}
+
+		if a.size <= view.ByteLength() {
+			if err := a.set(view, view.ByteLength()-a.size); err != nil {
+				t.Errorf("%s Set at the last offset returned %v", a.name, err)
+			}
+		}
+	}
+
+	if !bytes.Equal(buf.Bytes()[:2], []byte{0, 0}) || !bytes.Equal(buf.Bytes()[8:], []byte{0, 0}) {
+		t.Errorf("writes through the view reached outside it: % x", buf.Bytes())
+	}
+}
+
+func TestNewDataView(t *testing.T) {
+	buf := NewArrayBuffer(8)
+
+	tests := []struct {
+		offset int
+		length []int
+		want   string
+	}{
+		{0, nil, "0 8"},
+		{3, nil, "3 5"},
+		{8, nil, "8 0"},
+		{2, []int{4}, "2 4"},
+		{2, []int{6}, "2 6"},
+		{2, []int{0}, "2 0"},
+		{9, nil, "error"},
+		{-1, nil, "error"},
+		{2, []int{7}, "error"},
+		{2, []int{-1}, "error"},
+	}
+
+	for _, tt := range tests {
+		view, err := NewDataView(buf, tt.offset, tt.length...)
+
+		got := "error"
+		if err == nil {
+			got = fmt.Sprint(view.ByteOffset(), " ", view.ByteLength())
+		} else if !errors.Is(err, ErrOutOfRange) {
+			got = err.Error()
+		}
+
+		if got != tt.want {
+			t.Errorf("NewDataView(%d, %v) = %s, want %s", tt.offset, tt.length, got, tt.want)
+		}
+
+		if err == nil && view.Buffer() != buf {
+			t.Errorf("NewDataView(%d, %v) does not view the buffer it was given", tt.offset, tt.length)
+		}
+	}
+}
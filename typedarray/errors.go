@@ -0,0 +1,12 @@
+package typedarray
+
+import "errors"
+
+var (
+	// ErrOutOfRange is returned when a view or an access would reach outside the bounds of its buffer.
+	ErrOutOfRange = errors.New("typedarray: offset is outside the bounds of the buffer")
+
+	// ErrAlignment is returned when a typed array view starts at a byte offset that is not a multiple
+	// of its element size, or covers a byte length that is not.
+	ErrAlignment = errors.New("typedarray: byte offset or length is not a multiple of the element size")
+)
@@ -0,0 +1,685 @@
+package typedarray
+
+import (
+	"encoding/binary"
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+	"strings"
+
+	jsarray "github.com/iVitaliya/javascript-go/array"
+	"github.com/iVitaliya/javascript-go/internal/bounds"
+)
+
+// Element is the set of Go types a typed array can hold.
+type Element interface {
+	~int8 | ~uint8 | ~int16 | ~uint16 | ~int32 | ~uint32 | ~float32 | ~float64
+}
+
+// TypedArray is an array-like view over an ArrayBuffer holding elements of a single numeric type,
+// the equivalent of the JavaScript typed arrays such as Uint8Array or Float64Array.
+//
+// Values written to a typed array are given as float64, the type of a JavaScript number, and converted
+// to the element type with the same semantics as JavaScript: integer arrays truncate and wrap around
+// (so 257 becomes 1 in a Uint8Array and 128 becomes -128 in an Int8Array), a Uint8ClampedArray clamps
+// to [0, 255] and rounds half to even, and float arrays round to the nearest representable value.
+//
+// Elements are stored in the native byte order of the platform, as they are in JavaScript.
+// Use a DataView to read and write with an explicit byte order.
+type TypedArray[T Element] struct {
+	buffer     *ArrayBuffer
+	byteOffset int
+	length     int
+	kind       *kind[T]
+}
+
+// Uint8Clamped is the element type of a Uint8ClampedArray. It is a uint8 of its own rather than a plain uint8,
+// so that a Uint8ClampedArray is a different type from a Uint8Array: a clamped array cannot be passed where
+// a wrapping one is expected, nor the other way round, although both read and write single bytes.
+type Uint8Clamped uint8
+
+type (
+	Int8Array         = TypedArray[int8]
+	Uint8Array        = TypedArray[uint8]
+	Uint8ClampedArray = TypedArray[Uint8Clamped]
+	Int16Array        = TypedArray[int16]
+	Uint16Array       = TypedArray[uint16]
+	Int32Array        = TypedArray[int32]
+	Uint32Array       = TypedArray[uint32]
+	Float32Array      = TypedArray[float32]
+	Float64Array      = TypedArray[float64]
+)
+
+// kind describes how the elements of a typed array are named, sized, decoded and encoded.
+type kind[T Element] struct {
+	name string
+	size int
+	get  func(b []byte) T
+	set  func(b []byte, value float64)
+}
+
+var (
+	int8Kind = &kind[int8]{
+		name: "Int8Array",
+		size: 1,
+		get:  func(b []byte) int8 { return int8(b[0]) },
+		set:  func(b []byte, v float64) { b[0] = byte(toUint(v, 8)) },
+	}
+	uint8Kind = &kind[uint8]{
+		name: "Uint8Array",
+		size: 1,
+		get:  func(b []byte) uint8 { return b[0] },
+		set:  func(b []byte, v float64) { b[0] = byte(toUint(v, 8)) },
+	}
+	uint8ClampedKind = &kind[Uint8Clamped]{
+		name: "Uint8ClampedArray",
+		size: 1,
+		get:  func(b []byte) Uint8Clamped { return Uint8Clamped(b[0]) },
+		set:  func(b []byte, v float64) { b[0] = toUint8Clamp(v) },
+	}
+	int16Kind = &kind[int16]{
+		name: "Int16Array",
+		size: 2,
+		get:  func(b []byte) int16 { return int16(binary.NativeEndian.Uint16(b)) },
+		set:  func(b []byte, v float64) { binary.NativeEndian.PutUint16(b, uint16(toUint(v, 16))) },
+	}
+	uint16Kind = &kind[uint16]{
+		name: "Uint16Array",
+		size: 2,
+		get:  func(b []byte) uint16 { return binary.NativeEndian.Uint16(b) },
+		set:  func(b []byte, v float64) { binary.NativeEndian.PutUint16(b, uint16(toUint(v, 16))) },
+	}
+	int32Kind = &kind[int32]{
+		name: "Int32Array",
+		size: 4,
+		get:  func(b []byte) int32 { return int32(binary.NativeEndian.Uint32(b)) },
+		set:  func(b []byte, v float64) { binary.NativeEndian.PutUint32(b, uint32(toUint(v, 32))) },
+	}
+	uint32Kind = &kind[uint32]{
+		name: "Uint32Array",
+		size: 4,
+		get:  func(b []byte) uint32 { return binary.NativeEndian.Uint32(b) },
+		set:  func(b []byte, v float64) { binary.NativeEndian.PutUint32(b, uint32(toUint(v, 32))) },
+	}
+	float32Kind = &kind[float32]{
+		name: "Float32Array",
+		size: 4,
+		get:  func(b []byte) float32 { return math.Float32frombits(binary.NativeEndian.Uint32(b)) },
+		set:  func(b []byte, v float64) { binary.NativeEndian.PutUint32(b, math.Float32bits(float32(v))) },
+	}
+	float64Kind = &kind[float64]{
+		name: "Float64Array",
+		size: 8,
+		get:  func(b []byte) float64 { return math.Float64frombits(binary.NativeEndian.Uint64(b)) },
+		set:  func(b []byte, v float64) { binary.NativeEndian.PutUint64(b, math.Float64bits(v)) },
+	}
+)
+
+// NewInt8Array returns a new Int8Array of the given length backed by a new zeroed buffer.
+func NewInt8Array(length int) *Int8Array {
+	return newTypedArray(int8Kind, length)
+}
+
+// Int8ArrayFrom returns a new Int8Array holding the given values, converted with wrap-around.
+func Int8ArrayFrom(values ...float64) *Int8Array {
+	return typedArrayFrom(int8Kind, values)
+}
+
+// NewInt8ArrayView returns an Int8Array viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewInt8ArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Int8Array, error) {
+	return newTypedArrayView(int8Kind, buffer, byteOffset, length)
+}
+
+// NewUint8Array returns a new Uint8Array of the given length backed by a new zeroed buffer.
+func NewUint8Array(length int) *Uint8Array {
+	return newTypedArray(uint8Kind, length)
+}
+
+// Uint8ArrayFrom returns a new Uint8Array holding the given values, converted with wrap-around.
+func Uint8ArrayFrom(values ...float64) *Uint8Array {
+	return typedArrayFrom(uint8Kind, values)
+}
+
+// NewUint8ArrayView returns a Uint8Array viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewUint8ArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Uint8Array, error) {
+	return newTypedArrayView(uint8Kind, buffer, byteOffset, length)
+}
+
+// NewUint8ClampedArray returns a new Uint8ClampedArray of the given length backed by a new zeroed buffer.
+func NewUint8ClampedArray(length int) *Uint8ClampedArray {
+	return newTypedArray(uint8ClampedKind, length)
+}
+
+// Uint8ClampedArrayFrom returns a new Uint8ClampedArray holding the given values, clamped to [0, 255].
+func Uint8ClampedArrayFrom(values ...float64) *Uint8ClampedArray {
+	return typedArrayFrom(uint8ClampedKind, values)
+}
+
+// NewUint8ClampedArrayView returns a Uint8ClampedArray viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewUint8ClampedArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Uint8ClampedArray, error) {
+	return newTypedArrayView(uint8ClampedKind, buffer, byteOffset, length)
+}
+
+// NewInt16Array returns a new Int16Array of the given length backed by a new zeroed buffer.
+func NewInt16Array(length int) *Int16Array {
+	return newTypedArray(int16Kind, length)
+}
+
+// Int16ArrayFrom returns a new Int16Array holding the given values, converted with wrap-around.
+func Int16ArrayFrom(values ...float64) *Int16Array {
+	return typedArrayFrom(int16Kind, values)
+}
+
+// NewInt16ArrayView returns an Int16Array viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewInt16ArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Int16Array, error) {
+	return newTypedArrayView(int16Kind, buffer, byteOffset, length)
+}
+
+// NewUint16Array returns a new Uint16Array of the given length backed by a new zeroed buffer.
+func NewUint16Array(length int) *Uint16Array {
+	return newTypedArray(uint16Kind, length)
+}
+
+// Uint16ArrayFrom returns a new Uint16Array holding the given values, converted with wrap-around.
+func Uint16ArrayFrom(values ...float64) *Uint16Array {
+	return typedArrayFrom(uint16Kind, values)
+}
+
+// NewUint16ArrayView returns a Uint16Array viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewUint16ArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Uint16Array, error) {
+	return newTypedArrayView(uint16Kind, buffer, byteOffset, length)
+}
+
+// NewInt32Array returns a new Int32Array of the given length backed by a new zeroed buffer.
+func NewInt32Array(length int) *Int32Array {
+	return newTypedArray(int32Kind, length)
+}
+
+// Int32ArrayFrom returns a new Int32Array holding the given values, converted with wrap-around.
+func Int32ArrayFrom(values ...float64) *Int32Array {
+	return typedArrayFrom(int32Kind, values)
+}
+
+// NewInt32ArrayView returns an Int32Array viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewInt32ArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Int32Array, error) {
+	return newTypedArrayView(int32Kind, buffer, byteOffset, length)
+}
+
+// NewUint32Array returns a new Uint32Array of the given length backed by a new zeroed buffer.
+func NewUint32Array(length int) *Uint32Array {
+	return newTypedArray(uint32Kind, length)
+}
+
+// Uint32ArrayFrom returns a new Uint32Array holding the given values, converted with wrap-around.
+func Uint32ArrayFrom(values ...float64) *Uint32Array {
+	return typedArrayFrom(uint32Kind, values)
+}
+
+// NewUint32ArrayView returns a Uint32Array viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewUint32ArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Uint32Array, error) {
+	return newTypedArrayView(uint32Kind, buffer, byteOffset, length)
+}
+
+// NewFloat32Array returns a new Float32Array of the given length backed by a new zeroed buffer.
+func NewFloat32Array(length int) *Float32Array {
+	return newTypedArray(float32Kind, length)
+}
+
+// Float32ArrayFrom returns a new Float32Array holding the given values, rounded to float32.
+func Float32ArrayFrom(values ...float64) *Float32Array {
+	return typedArrayFrom(float32Kind, values)
+}
+
+// NewFloat32ArrayView returns a Float32Array viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewFloat32ArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Float32Array, error) {
+	return newTypedArrayView(float32Kind, buffer, byteOffset, length)
+}
+
+// NewFloat64Array returns a new Float64Array of the given length backed by a new zeroed buffer.
+func NewFloat64Array(length int) *Float64Array {
+	return newTypedArray(float64Kind, length)
+}
+
+// Float64ArrayFrom returns a new Float64Array holding the given values.
+func Float64ArrayFrom(values ...float64) *Float64Array {
+	return typedArrayFrom(float64Kind, values)
+}
+
+// NewFloat64ArrayView returns a Float64Array viewing the given buffer from byteOffset.
+// If no length is given, the view extends to the end of the buffer.
+func NewFloat64ArrayView(buffer *ArrayBuffer, byteOffset int, length ...int) (*Float64Array, error) {
+	return newTypedArrayView(float64Kind, buffer, byteOffset, length)
+}
+
+func newTypedArray[T Element](k *kind[T], length int) *TypedArray[T] {
+	if length < 0 {
+		length = 0
+	}
+
+	return &TypedArray[T]{
+		buffer: NewArrayBuffer(length * k.size),
+		length: length,
+		kind:   k,
+	}
+}
+
+func typedArrayFrom[T Element](k *kind[T], values []float64) *TypedArray[T] {
+	arr := newTypedArray(k, len(values))
+
+	for i, v := range values {
+		arr.Set(i, v)
+	}
+
+	return arr
+}
+
+func newTypedArrayView[T Element](k *kind[T], buffer *ArrayBuffer, byteOffset int, length []int) (*TypedArray[T], error) {
+	if byteOffset < 0 || byteOffset > buffer.ByteLength() {
+		return nil, ErrOutOfRange
+	}
+
+	if byteOffset%k.size != 0 {
+		return nil, ErrAlignment
+	}
+
+	if len(length) == 0 {
+		remaining := buffer.ByteLength() - byteOffset
+		if remaining%k.size != 0 {
+			return nil, ErrAlignment
+		}
+
+		length = []int{remaining / k.size}
+	}
+
+	if length[0] < 0 || byteOffset+length[0]*k.size > buffer.ByteLength() {
+		return nil, ErrOutOfRange
+	}
+
+	return &TypedArray[T]{
+		buffer:     buffer,
+		byteOffset: byteOffset,
+		length:     length[0],
+		kind:       k,
+	}, nil
+}
+
+// Buffer returns the buffer the typed array views.
+func (array *TypedArray[T]) Buffer() *ArrayBuffer {
+	return array.buffer
+}
+
+// ByteOffset returns the offset in bytes of the typed array from the start of its buffer.
+func (array *TypedArray[T]) ByteOffset() int {
+	return array.byteOffset
+}
+
+// ByteLength returns the length in bytes of the typed array.
+func (array *TypedArray[T]) ByteLength() int {
+	return array.length * array.kind.size
+}
+
+// BytesPerElement returns the size in bytes of each element of the typed array.
+func (array *TypedArray[T]) BytesPerElement() int {
+	return array.kind.size
+}
+
+// Length returns the number of elements in the typed array.
+func (array *TypedArray[T]) Length() int {
+	return array.length
+}
+
+// At returns the value at the given index.
+// Negative indices count back from the end of the typed array, so At(-1) returns the last element.
+// If the index is out of range, it returns a zero value of type T.
+func (array *TypedArray[T]) At(index int) T {
+	k, ok := bounds.Relative(index, array.length)
+	if !ok {
+		return 0
+	}
+
+	return array.get(k)
+}
+
+// Set converts the given value to the element type and stores it at the given index.
+// Like an assignment to an out-of-range index of a JavaScript typed array, it does nothing if the
+// index is out of range; negative indices are not relative to the end.
+func (array *TypedArray[T]) Set(index int, value float64) {
+	if index < 0 || index >= array.length {
+		return
+	}
+
+	array.kind.set(array.bytes(index), value)
+}
+
+// CopyWithin copies the elements from the start index up to but not including the end index to the target
+// index within the same typed array, and returns the typed array. See array.Array.CopyWithin.
+func (array *TypedArray[T]) CopyWithin(target, start int, end ...int) *TypedArray[T] {
+	var (
+		to    = bounds.Clamp(target, array.length)
+		from  = bounds.Clamp(start, array.length)
+		final = bounds.End(end, array.length)
+		count = min(final-from, array.length-to)
+	)
+
+	if count > 0 {
+		size := array.kind.size
+		data := array.buffer.data[array.byteOffset:]
+		copy(data[to*size:(to+count)*size], data[from*size:(from+count)*size])
+	}
+
+	return array
+}
+
+// Entries returns an iterator over the index/value pairs of the typed array.
+func (array *TypedArray[T]) Entries() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < array.length; i++ {
+			if !yield(i, array.get(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Every tests whether all elements in the typed array pass the test implemented by the provided function.
+func (array *TypedArray[T]) Every(fn func(value T, index int, array *TypedArray[T]) bool) bool {
+	for i := 0; i < array.length; i++ {
+		if !fn(array.get(i), i, array) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Fill fills the elements of the typed array from a start index to an end index (exclusive) with the given value,
+// converted to the element type, and returns the typed array.
+// Negative indices are treated as offsets from the end, indices out of range are clamped, and if no end index
+// is given the typed array is filled up to its end.
+func (array *TypedArray[T]) Fill(value float64, start int, end ...int) *TypedArray[T] {
+	var (
+		from  = bounds.Clamp(start, array.length)
+		final = bounds.End(end, array.length)
+	)
+
+	for i := from; i < final; i++ {
+		array.kind.set(array.bytes(i), value)
+	}
+
+	return array
+}
+
+// Filter returns a new typed array of the same kind with all elements that pass the test implemented by the provided function.
+func (array *TypedArray[T]) Filter(fn func(value T, index int, array *TypedArray[T]) bool) *TypedArray[T] {
+	var kept []T
+
+	for i := 0; i < array.length; i++ {
+		if v := array.get(i); fn(v, i, array) {
+			kept = append(kept, v)
+		}
+	}
+
+	result := newTypedArray(array.kind, len(kept))
+	for i, v := range kept {
+		result.kind.set(result.bytes(i), float64(v))
+	}
+
+	return result
+}
+
+// Find returns the first element in the typed array that satisfies the provided testing function, and true if found.
+func (array *TypedArray[T]) Find(fn func(value T, index int, array *TypedArray[T]) bool) (T, bool) {
+	for i := 0; i < array.length; i++ {
+		if v := array.get(i); fn(v, i, array) {
+			return v, true
+		}
+	}
+
+	return 0, false
+}
+
+// FindIndex returns the index of the first element in the typed array that satisfies the provided testing function,
+// or -1 and false if there is none.
+func (array *TypedArray[T]) FindIndex(fn func(value T, index int, array *TypedArray[T]) bool) (int, bool) {
+	for i := 0; i < array.length; i++ {
+		if fn(array.get(i), i, array) {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+// ForEach calls the provided function once for each element of the typed array in ascending order.
+func (array *TypedArray[T]) ForEach(fn func(value T, index int, array *TypedArray[T])) {
+	for i := 0; i < array.length; i++ {
+		fn(array.get(i), i, array)
+	}
+}
+
+// Includes determines whether the typed array includes a certain value. Like in JavaScript, NaN is found by Includes.
+func (array *TypedArray[T]) Includes(search_term T) bool {
+	if search_term != search_term {
+		for i := 0; i < array.length; i++ {
+			if v := array.get(i); v != v {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return array.IndexOf(search_term) != -1
+}
+
+// IndexOf returns the index of the first occurrence of the given value in the typed array, or -1 if it is not present.
+// Like in JavaScript, NaN is never found by IndexOf.
+func (array *TypedArray[T]) IndexOf(search_term T) int {
+	for i := 0; i < array.length; i++ {
+		if array.get(i) == search_term {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Join joins all elements of the typed array into a string, separated by the given separator.
+func (array *TypedArray[T]) Join(separator string) string {
+	var b strings.Builder
+
+	for i := 0; i < array.length; i++ {
+		if i > 0 {
+			b.WriteString(separator)
+		}
+
+		fmt.Fprint(&b, array.get(i))
+	}
+
+	return b.String()
+}
+
+// Keys returns an iterator over the indices of the typed array.
+func (array *TypedArray[T]) Keys() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < array.length; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a new typed array of the same kind holding the results of calling the provided function on every element.
+// The results are given as float64 and converted to the element type, so they wrap around or clamp as they would in JavaScript.
+func (array *TypedArray[T]) Map(fn func(value T, index int, array *TypedArray[T]) float64) *TypedArray[T] {
+	result := newTypedArray(array.kind, array.length)
+
+	for i := 0; i < array.length; i++ {
+		result.kind.set(result.bytes(i), fn(array.get(i), i, array))
+	}
+
+	return result
+}
+
+// Reverse reverses the elements of the typed array in place and returns it.
+func (array *TypedArray[T]) Reverse() *TypedArray[T] {
+	for i, j := 0, array.length-1; i < j; i, j = i+1, j-1 {
+		a, b := array.get(i), array.get(j)
+		array.put(i, b)
+		array.put(j, a)
+	}
+
+	return array
+}
+
+// Slice returns a new typed array of the same kind, backed by a new buffer, holding a copy of the elements
+// from the start index to the end index (exclusive). Negative indices are treated as offsets from the end,
+// and indices out of range are clamped. If no end index is given, the copy extends to the end.
+func (array *TypedArray[T]) Slice(start int, end ...int) *TypedArray[T] {
+	var (
+		from  = bounds.Clamp(start, array.length)
+		final = max(bounds.End(end, array.length), from)
+		size  = array.kind.size
+	)
+
+	result := newTypedArray(array.kind, final-from)
+	copy(result.buffer.data, array.buffer.data[array.byteOffset+from*size:array.byteOffset+final*size])
+
+	return result
+}
+
+// Some tests whether at least one element in the typed array passes the test implemented by the provided function.
+func (array *TypedArray[T]) Some(fn func(value T, index int, array *TypedArray[T]) bool) bool {
+	for i := 0; i < array.length; i++ {
+		if fn(array.get(i), i, array) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Sort sorts the elements of the typed array in place and returns it. The sort is stable.
+// Without a comparison function, elements are sorted in ascending numeric order with NaN last, as in JavaScript.
+// Otherwise the comparison function returns a negative number if a should come before b, a positive
+// number if it should come after, and zero if their order does not matter.
+func (array *TypedArray[T]) Sort(fn ...func(a, b T) int) *TypedArray[T] {
+	values := array.values()
+	slices.SortStableFunc(values, compareFunc(fn))
+
+	for i, v := range values {
+		array.put(i, v)
+	}
+
+	return array
+}
+
+// Subarray returns a new typed array of the same kind viewing the same buffer, from the start index to the
+// end index (exclusive) of this typed array. Unlike Slice, no memory is copied, so writes through either
+// typed array are visible in the other. Indices are resolved like they are for Slice.
+func (array *TypedArray[T]) Subarray(start int, end ...int) *TypedArray[T] {
+	var (
+		from  = bounds.Clamp(start, array.length)
+		final = max(bounds.End(end, array.length), from)
+	)
+
+	return &TypedArray[T]{
+		buffer:     array.buffer,
+		byteOffset: array.byteOffset + from*array.kind.size,
+		length:     final - from,
+		kind:       array.kind,
+	}
+}
+
+// ToArray returns a new array.Array holding a copy of the elements of the typed array.
+func (array *TypedArray[T]) ToArray() *jsarray.Array[T] {
+	return jsarray.NewWithEntries(array.values())
+}
+
+// ToSorted returns a new typed array of the same kind with the elements sorted, leaving this typed array unchanged.
+// The comparison function is optional and behaves as it does for Sort.
+func (array *TypedArray[T]) ToSorted(fn ...func(a, b T) int) *TypedArray[T] {
+	return array.Slice(0).Sort(fn...)
+}
+
+// ToString returns the elements of the typed array joined by commas, as the JavaScript toString does.
+func (array *TypedArray[T]) ToString() string {
+	return array.Join(",")
+}
+
+// Values returns an iterator over the elements of the typed array.
+func (array *TypedArray[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < array.length; i++ {
+			if !yield(array.get(i)) {
+				return
+			}
+		}
+	}
+}
+
+// String implements fmt.Stringer, formatting the typed array like Node does, e.g. Uint8Array(3) [ 1, 2, 3 ].
+func (array *TypedArray[T]) String() string {
+	return fmt.Sprintf("%s(%d) [ %s ]", array.kind.name, array.length, array.Join(", "))
+}
+
+// bytes returns the bytes of the element at the given index.
+func (array *TypedArray[T]) bytes(index int) []byte {
+	offset := array.byteOffset + index*array.kind.size
+
+	return array.buffer.data[offset : offset+array.kind.size]
+}
+
+// get decodes the element at the given index.
+func (array *TypedArray[T]) get(index int) T {
+	return array.kind.get(array.bytes(index))
+}
+
+// put stores a value that already has the element type at the given index. It goes through the float64
+// setter of the kind like any other write, but since float64 holds every value of every element type
+// exactly, the value is stored unchanged rather than wrapped around or clamped.
+func (array *TypedArray[T]) put(index int, value T) {
+	array.kind.set(array.bytes(index), float64(value))
+}
+
+// values decodes all elements of the typed array into a new slice.
+func (array *TypedArray[T]) values() []T {
+	result := make([]T, array.length)
+	for i := range result {
+		result[i] = array.get(i)
+	}
+
+	return result
+}
+
+// compareFunc returns the comparison function given to Sort, or the default numeric comparison.
+func compareFunc[T Element](fn []func(a, b T) int) func(a, b T) int {
+	if len(fn) > 0 && fn[0] != nil {
+		return fn[0]
+	}
+
+	return func(a, b T) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		case a != a && b == b:
+			return 1
+		case a == a && b != b:
+			return -1
+		}
+
+		return 0
+	}
+}
@@ -0,0 +1,160 @@
+package typedarray
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// The expected values are those JavaScript stores for the same numbers, following the ToInt8, ToUint8,
+// ToUint8Clamp, ToInt16, ToUint16, ToInt32 and ToUint32 abstract operations.
+
+var conversionInputs = []float64{
+	0, math.Copysign(0, -1), 1.9, -1.9, 127, 128, 255, 256, 257, -1, -128, -129,
+	65535, 65536, 70000, 2147483648, 4294967297, -4294967297, 1e10,
+	math.NaN(), math.Inf(1), math.Inf(-1),
+}
+
+func TestIntegerWrapAround(t *testing.T) {
+	t.Run("Int8Array", func(t *testing.T) {
+		want := []int8{0, 0, 1, -1, 127, -128, -1, 0, 1, -1, -128, 127, -1, 0, 112, 0, 1, -1, 0, 0, 0, 0}
+		if got := Int8ArrayFrom(conversionInputs...).values(); !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Uint8Array", func(t *testing.T) {
+		want := []uint8{0, 0, 1, 255, 127, 128, 255, 0, 1, 255, 128, 127, 255, 0, 112, 0, 1, 255, 0, 0, 0, 0}
+		if got := Uint8ArrayFrom(conversionInputs...).values(); !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Int16Array", func(t *testing.T) {
+		want := []int16{0, 0, 1, -1, 127, 128, 255, 256, 257, -1, -128, -129, -1, 0, 4464, 0, 1, -1, -7168, 0, 0, 0}
+		if got := Int16ArrayFrom(conversionInputs...).values(); !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Uint16Array", func(t *testing.T) {
+		want := []uint16{0, 0, 1, 65535, 127, 128, 255, 256, 257, 65535, 65408, 65407, 65535, 0, 4464, 0, 1, 65535, 58368, 0, 0, 0}
+		if got := Uint16ArrayFrom(conversionInputs...).values(); !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Int32Array", func(t *testing.T) {
+		want := []int32{
+			0, 0, 1, -1, 127, 128, 255, 256, 257, -1, -128, -129,
+			65535, 65536, 70000, -2147483648, 1, -1, 1410065408, 0, 0, 0,
+		}
+		if got := Int32ArrayFrom(conversionInputs...).values(); !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Uint32Array", func(t *testing.T) {
+		want := []uint32{
+			0, 0, 1, 4294967295, 127, 128, 255, 256, 257, 4294967295, 4294967168, 4294967167,
+			65535, 65536, 70000, 2147483648, 1, 4294967295, 1410065408, 0, 0, 0,
+		}
+		if got := Uint32ArrayFrom(conversionInputs...).values(); !slices.Equal(got, want) {
+			t.Errorf("got %v, want %v", got, want)
+		}
+	})
+}
+
+func TestUint8Clamp(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  Uint8Clamped
+	}{
+		{-1, 0},
+		{math.Copysign(0, -1), 0},
+		{0.4, 0},
+		{0.5, 0}, // ties round to even
+		{1.5, 2},
+		{2.5, 2},
+		{3.5, 4},
+		{254.5, 254},
+		{254.6, 255},
+		{255, 255},
+		{255.5, 255},
+		{300, 255},
+		{math.NaN(), 0},
+		{math.Inf(1), 255},
+		{math.Inf(-1), 0},
+	}
+
+	for _, tt := range tests {
+		if got := Uint8ClampedArrayFrom(tt.value).At(0); got != tt.want {
+			t.Errorf("Uint8ClampedArrayFrom(%v) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestUint8ClampedArrayMethodsClamp(t *testing.T) {
+	arr := NewUint8ClampedArray(3)
+
+	arr.Set(0, 1000)
+	arr.Fill(-5, 1, 2)
+	arr.Set(2, 127.5)
+
+	if got, want := arr.values(), []Uint8Clamped{255, 0, 128}; !slices.Equal(got, want) {
+		t.Errorf("after Set and Fill got %v, want %v", got, want)
+	}
+
+	doubled := arr.Map(func(value Uint8Clamped, index int, array *Uint8ClampedArray) float64 {
+		return float64(value) * 2
+	})
+
+	if got, want := doubled.values(), []Uint8Clamped{255, 0, 255}; !slices.Equal(got, want) {
+		t.Errorf("Map(x * 2) = %v, want %v", got, want)
+	}
+
+	if got, want := arr.String(), "Uint8ClampedArray(3) [ 255, 0, 128 ]"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestUint8ArrayViewSharesBufferWithClampedView(t *testing.T) {
+	buf := NewArrayBuffer(2)
+	wrapping, _ := NewUint8ArrayView(buf, 0)
+	clamped, _ := NewUint8ClampedArrayView(buf, 0)
+
+	wrapping.Set(0, 300)
+	clamped.Set(1, 300)
+
+	if got, want := wrapping.values(), []uint8{44, 255}; !slices.Equal(got, want) {
+		t.Errorf("Uint8Array view = %v, want %v", got, want)
+	}
+
+	if got := clamped.At(0); got != 44 {
+		t.Errorf("Uint8ClampedArray view At(0) = %d, want 44", got)
+	}
+}
+
+func TestFloat32ArrayRounds(t *testing.T) {
+	arr := Float32ArrayFrom(1.1, 1e40, math.NaN())
+
+	if arr.At(0) != float32(1.1) || !math.IsInf(float64(arr.At(1)), 1) || !math.IsNaN(float64(arr.At(2))) {
+		t.Errorf("Float32ArrayFrom(1.1, 1e40, NaN) = %v", arr.values())
+	}
+}
+
+func TestTypedArrayRelativeIndices(t *testing.T) {
+	arr := Int8ArrayFrom(1, 2, 3, 4, 5)
+
+	if got := arr.At(-1); got != 5 {
+		t.Errorf("At(-1) = %d, want 5", got)
+	}
+
+	if got, want := arr.Slice(-3, -1).values(), []int8{3, 4}; !slices.Equal(got, want) {
+		t.Errorf("Slice(-3, -1) = %v, want %v", got, want)
+	}
+
+	if got, want := arr.Subarray(1, 10).values(), []int8{2, 3, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("Subarray(1, 10) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,39 @@
+package typedarray
+
+import (
+	"math"
+)
+
+// toUint converts a number to an unsigned integer of the given bit size the way the ToInt8, ToUint8,
+// ToInt16 ... ToUint32 abstract operations of JavaScript do: NaN and infinities become 0, the number is
+// truncated towards zero and then wrapped modulo 2^bits. The signed variants are obtained by converting
+// the result to the signed Go type of the same size.
+func toUint(value float64, bits int) uint64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0
+	}
+
+	var (
+		modulo = math.Exp2(float64(bits))
+		result = math.Mod(math.Trunc(value), modulo)
+	)
+
+	if result < 0 {
+		result += modulo
+	}
+
+	return uint64(result)
+}
+
+// toUint8Clamp converts a number to an unsigned 8-bit integer the way the ToUint8Clamp abstract operation
+// of JavaScript does: NaN becomes 0, values are clamped to [0, 255] and rounded half to even.
+func toUint8Clamp(value float64) uint8 {
+	switch {
+	case math.IsNaN(value) || value <= 0:
+		return 0
+	case value >= 255:
+		return 255
+	}
+
+	return uint8(math.RoundToEven(value))
+}